@@ -8,10 +8,7 @@ func TestAdvLvlUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := AdvancementLevelAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := AdvancementLevelEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,129 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// AgeState describes whether a legal minimum age is in force.
+type AgeState int
+
+const (
+	AgeUnknown AgeState = iota
+	AgeEstablished
+	AgeNotEstablished
+)
+
+func (s AgeState) String() string {
+	switch s {
+	case AgeEstablished:
+		return "Established"
+	case AgeNotEstablished:
+		return "Not Established"
+	}
+
+	return "Unknown"
+}
+
+// Age is a legal minimum age decoded from the API, which reports ages as
+// strings such as "15". Years is only meaningful when State is
+// AgeEstablished.
+type Age struct {
+	Years int
+	State AgeState
+	// Raw is the JSON the API sent for the age, e.g. `"15 years"` or `15`.
+	Raw string
+}
+
+// Value returns the age in years, and whether the age is established.
+func (a Age) Value() (int, bool) {
+	return a.Years, a.State == AgeEstablished
+}
+
+func (a Age) String() string {
+	if a.State == AgeEstablished {
+		return strconv.Itoa(a.Years)
+	}
+
+	return a.State.String()
+}
+
+// UnmarshalJSON decodes an age from a JSON string, number or null. A string
+// starting with a number, such as "15" or "15 years", is an established
+// age; "No" or "None" is an age that has not been established; anything
+// else, including "N/A" and blanks, is unknown.
+func (a *Age) UnmarshalJSON(b []byte) error {
+	raw, isNull, err := decodeScalar(b)
+	if err != nil {
+		return fmt.Errorf("Invalid age: %s", b)
+	}
+
+	*a = Age{}
+	if !isNull {
+		*a = parseAge(raw)
+	}
+	a.Raw = string(bytes.TrimSpace(b))
+
+	return nil
+}
+
+// MarshalJSON writes Raw while it still decodes to the age, including an age
+// since marked not established by its status flag. Other ages are written
+// as a string of their years, as the API does, or null.
+func (a Age) MarshalJSON() ([]byte, error) {
+	if rawMatches(a, a.Raw, Age.notEstablished) {
+		return []byte(a.Raw), nil
+	}
+
+	if a.State == AgeEstablished {
+		return json.Marshal(strconv.Itoa(a.Years))
+	}
+
+	return []byte("null"), nil
+}
+
+func parseAge(raw string) Age {
+	var a Age
+	s := strings.TrimSpace(raw)
+
+	end := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) })
+	if end == -1 {
+		end = len(s)
+	}
+
+	if years, err := strconv.Atoi(s[:end]); err == nil {
+		a.Years = years
+		a.State = AgeEstablished
+		return a
+	}
+
+	switch strings.ToLower(s) {
+	case "no", "none", "not established":
+		a.State = AgeNotEstablished
+	}
+
+	return a
+}
+
+// withStatus applies a separate "established" flag returned by the API. An
+// age flagged as not established is reported as such even if a number was
+// given.
+func (a Age) withStatus(status string) Age {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "no", "n", "false", "0":
+		return a.notEstablished()
+	}
+
+	return a
+}
+
+func (a Age) notEstablished() Age {
+	a.Years = 0
+	a.State = AgeNotEstablished
+
+	return a
+}
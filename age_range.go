@@ -0,0 +1,199 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Matches age ranges such as "5-14", "5 - 14", "5 to 14" or a single age,
+// ignoring trailing text such as "years".
+var ageRangePattern = regexp.MustCompile(`^\s*(\d+)(?:\s*(?:-|–|to)\s*(\d+))?`)
+
+// AgeRange is the span of ages a statistic was measured over, decoded from
+// strings such as "5-14". Statistics are only comparable when they share an
+// age range.
+type AgeRange struct {
+	// Min is the youngest age in the range.
+	Min int
+	// Max is the oldest age in the range.
+	Max int
+	// Raw is the range's JSON, e.g. `"5-14"`, `"15+"` or `14`.
+	Raw string
+}
+
+// Missing reports whether the range holds no ages.
+func (r AgeRange) Missing() bool {
+	return r.Max == 0
+}
+
+// Contains reports whether age falls within the range.
+func (r AgeRange) Contains(age int) bool {
+	return !r.Missing() && age >= r.Min && age <= r.Max
+}
+
+// ContainsRange reports whether every age in o falls within the range.
+func (r AgeRange) ContainsRange(o AgeRange) bool {
+	return !r.Missing() && !o.Missing() && o.Min >= r.Min && o.Max <= r.Max
+}
+
+// Equal reports whether the ranges span the same ages, regardless of how the
+// API formatted them.
+func (r AgeRange) Equal(o AgeRange) bool {
+	return r.Min == o.Min && r.Max == o.Max
+}
+
+// String returns the range as "5-14", or "" if it is missing.
+func (r AgeRange) String() string {
+	switch {
+	case r.Missing():
+		return ""
+	case r.Min == r.Max:
+		return strconv.Itoa(r.Min)
+	}
+
+	return fmt.Sprintf("%d-%d", r.Min, r.Max)
+}
+
+// UnmarshalJSON decodes a range from a JSON string, number or null. Values
+// which cannot be parsed, including open ranges such as "15+", decode as a
+// missing range, keeping Raw.
+func (r *AgeRange) UnmarshalJSON(b []byte) error {
+	raw, isNull, err := decodeScalar(b)
+	if err != nil {
+		return fmt.Errorf("Invalid age range: %s", b)
+	}
+
+	*r = AgeRange{}
+	if !isNull {
+		*r = parseAgeRange(raw)
+	}
+	r.Raw = string(bytes.TrimSpace(b))
+
+	return nil
+}
+
+// MarshalJSON writes Raw if the range is as decoded, which keeps open
+// ranges such as "15+" that have no Min or Max. Other ranges are written as
+// their String, or null.
+func (r AgeRange) MarshalJSON() ([]byte, error) {
+	if rawMatches(r, r.Raw) {
+		return []byte(r.Raw), nil
+	}
+
+	if r.Missing() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(r.String())
+}
+
+func parseAgeRange(raw string) AgeRange {
+	var r AgeRange
+
+	m := ageRangePattern.FindStringSubmatch(raw)
+	if m == nil || strings.HasPrefix(strings.TrimSpace(raw[len(m[0]):]), "+") {
+		return r
+	}
+
+	lo, _ := strconv.Atoi(m[1])
+	hi := lo
+	if m[2] != "" {
+		hi, _ = strconv.Atoi(m[2])
+	}
+
+	if hi == 0 || hi < lo {
+		return r
+	}
+
+	r.Min, r.Max = lo, hi
+
+	return r
+}
+
+// AgeRangeOutlier is a statistic measured over a different age range from
+// the one most commonly used for it.
+type AgeRangeOutlier struct {
+	// Stat is the statistics row holding the outlying range.
+	Stat CountryStat
+	// Field is the JSON name of the age range field, e.g. "cws_age_range".
+	Field string
+	// Range is the outlying age range.
+	Range AgeRange
+	// Common is the age range most commonly used for the field.
+	Common AgeRange
+}
+
+// AgeRangeOutliers flags the rows of stats, typically from several
+// countries, whose age ranges differ from the range most commonly used for
+// the same statistic. Such rows should not be compared directly with the
+// others. Missing ranges are ignored.
+func AgeRangeOutliers(stats []CountryStat) []AgeRangeOutlier {
+	fields := []struct {
+		name  string
+		value func(CountryStat) AgeRange
+	}{
+		{"cws_age_range", func(s CountryStat) AgeRange { return s.CWAgeRange }},
+		{"esas_age_range", func(s CountryStat) AgeRange { return s.SchoolAttAgeRange }},
+		{"cwas_age_range", func(s CountryStat) AgeRange { return s.CWASAgeRange }},
+	}
+
+	var outliers []AgeRangeOutlier
+
+	for _, f := range fields {
+		common := commonAgeRange(stats, f.value)
+
+		for _, s := range stats {
+			r := f.value(s)
+			if r.Missing() || r.Equal(common) {
+				continue
+			}
+
+			outliers = append(outliers, AgeRangeOutlier{
+				Stat:   s,
+				Field:  f.name,
+				Range:  r,
+				Common: common,
+			})
+		}
+	}
+
+	return outliers
+}
+
+// commonAgeRange returns the most frequent non-missing range, preferring the
+// first seen when several are equally frequent.
+func commonAgeRange(stats []CountryStat, value func(CountryStat) AgeRange) AgeRange {
+	type span struct{ min, max int }
+
+	counts := make(map[span]int)
+
+	var seen []AgeRange
+
+	for _, s := range stats {
+		r := value(s)
+		if r.Missing() {
+			continue
+		}
+
+		k := span{r.Min, r.Max}
+		if counts[k] == 0 {
+			seen = append(seen, r)
+		}
+		counts[k]++
+	}
+
+	var common AgeRange
+	best := 0
+
+	for _, r := range seen {
+		if n := counts[span{r.Min, r.Max}]; n > best {
+			common, best = r, n
+		}
+	}
+
+	return common
+}
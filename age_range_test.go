@@ -0,0 +1,107 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAgeRangeUnmarshalJSON(t *testing.T) {
+	cases := map[string]AgeRange{
+		`"5-14"`:           {5, 14, `"5-14"`},
+		`"7 - 14"`:         {7, 14, `"7 - 14"`},
+		`"10 to 17 years"`: {10, 17, `"10 to 17 years"`},
+		`"15"`:             {15, 15, `"15"`},
+		`"14-5"`:           {0, 0, `"14-5"`},
+		`"N/A"`:            {0, 0, `"N/A"`},
+		`null`:             {0, 0, `null`},
+	}
+
+	for in, expected := range cases {
+		var r AgeRange
+		if err := json.Unmarshal([]byte(in), &r); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if r != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, r, expected)
+		}
+	}
+
+	var r AgeRange
+	if err := json.Unmarshal([]byte(`true`), &r); err == nil {
+		t.Error("Boolean accepted as age range.")
+	}
+}
+
+func TestCountryStatNumericAgeRange(t *testing.T) {
+	var s CountryStat
+	err := json.Unmarshal([]byte(`{"country_profile_id": 1, "cws_age_range": 14, "esas_age_range": "15+"}`), &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.CWAgeRange != (AgeRange{14, 14, "14"}) || !s.SchoolAttAgeRange.Missing() {
+		t.Error("Invalid age ranges: ", s.CWAgeRange, s.SchoolAttAgeRange)
+	}
+}
+
+func TestAgeRangeComparison(t *testing.T) {
+	r5 := parseAgeRange("5-14")
+	r7 := parseAgeRange("7 - 14")
+	missing := parseAgeRange("")
+
+	if !r5.Contains(5) || !r5.Contains(14) || r5.Contains(15) || missing.Contains(0) {
+		t.Error("Invalid Contains result.")
+	}
+
+	if !r5.ContainsRange(r7) || r7.ContainsRange(r5) || r5.ContainsRange(missing) {
+		t.Error("Invalid ContainsRange result.")
+	}
+
+	if !r7.Equal(parseAgeRange("7-14")) || r5.Equal(r7) {
+		t.Error("Invalid Equal result.")
+	}
+
+	if r7.String() != "7-14" || missing.String() != "" {
+		t.Error("Invalid String result.")
+	}
+
+	b, err := json.Marshal(AgeRange{Min: 5, Max: 17})
+	if err != nil || string(b) != `"5-17"` {
+		t.Error("Invalid marshaled age range: ", string(b), err)
+	}
+}
+
+func TestAgeRangeOutliers(t *testing.T) {
+	stats := []CountryStat{
+		{CountryProfileID: 1, CWAgeRange: parseAgeRange("5-14"), CWASAgeRange: parseAgeRange("7-14")},
+		{CountryProfileID: 2, CWAgeRange: parseAgeRange("5 - 14"), CWASAgeRange: parseAgeRange("7-14")},
+		{CountryProfileID: 3, CWAgeRange: parseAgeRange("5-17"), CWASAgeRange: parseAgeRange("")},
+	}
+
+	outliers := AgeRangeOutliers(stats)
+	if len(outliers) != 1 {
+		t.Fatal("Invalid outliers: ", outliers)
+	}
+
+	o := outliers[0]
+	if o.Stat.CountryProfileID != 3 || o.Field != "cws_age_range" ||
+		o.Range.String() != "5-17" || o.Common.String() != "5-14" {
+		t.Error("Invalid outlier: ", o)
+	}
+}
+
+func TestCommonAgeRangeTie(t *testing.T) {
+	stats := []CountryStat{
+		{CWAgeRange: parseAgeRange("5-14")},
+		{CWAgeRange: parseAgeRange("5-17")},
+		{CWAgeRange: parseAgeRange("5-17")},
+		{CWAgeRange: parseAgeRange("5-14")},
+	}
+
+	common := commonAgeRange(stats, func(s CountryStat) AgeRange { return s.CWAgeRange })
+	if common.String() != "5-14" {
+		t.Error("Tie not resolved to the first range seen: ", common)
+	}
+}
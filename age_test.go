@@ -0,0 +1,59 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAgeUnmarshalJSON(t *testing.T) {
+	cases := map[string]Age{
+		`"15"`:       {15, AgeEstablished, `"15"`},
+		`" 14 "`:     {14, AgeEstablished, `" 14 "`},
+		`"16 years"`: {16, AgeEstablished, `"16 years"`},
+		`18`:         {18, AgeEstablished, `18`},
+		`"No"`:       {0, AgeNotEstablished, `"No"`},
+		`"N/A"`:      {0, AgeUnknown, `"N/A"`},
+		`""`:         {0, AgeUnknown, `""`},
+		`null`:       {0, AgeUnknown, `null`},
+	}
+
+	for in, expected := range cases {
+		var a Age
+		if err := json.Unmarshal([]byte(in), &a); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if a != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, a, expected)
+		}
+	}
+
+	var a Age
+	if err := json.Unmarshal([]byte(`true`), &a); err == nil {
+		t.Error("Boolean accepted as age.")
+	}
+}
+
+func TestAgeMarshalJSON(t *testing.T) {
+	cases := map[string]Age{
+		`"15"`: {15, AgeEstablished, `"15"`},
+		`15`:   {15, AgeEstablished, `15`},
+		`"16"`: {Years: 16, State: AgeEstablished},
+		`"No"`: {0, AgeNotEstablished, `"No"`},
+		`"17"`: {17, AgeEstablished, `"15"`},
+		`null`: {0, AgeUnknown, `"15"`},
+		`"14"`: {0, AgeNotEstablished, `"14"`},
+	}
+
+	for expected, a := range cases {
+		b, err := json.Marshal(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != expected {
+			t.Errorf("Marshal(%+v) = %s, expected %s", a, b, expected)
+		}
+	}
+}
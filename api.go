@@ -3,14 +3,17 @@
 package laborstats
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const (
@@ -20,41 +23,62 @@ const (
 
 	// Custom request header containing the API secret key
 	secretKeyHeader = "X-API-KEY"
+
+	// Maximum length of a response body included in an error.
+	maxBodySnippet = 256
 )
 
 var (
-	LaborStatsAPIError   = errors.New("The API request returned an error.")
-	invalidFilterError   = errors.New("Invalid query parameter provided.")
-	invalidResponseError = errors.New("The HTTP request failed.")
-
-	// validFiltersKeys holds an array of currently available query filters.
-	validFilterKeys = []string{"limit", "date_column", "start_date", "end_date", "order"}
+	// validFiltersKeys holds an array of currently available query filters, in
+	// the order they are rendered in a request path.
+	validFilterKeys = []string{
+		"limit",
+		"offset",
+		"order",
+		"sort_by",
+		"sort",
+		"date_column",
+		"start_date",
+		"end_date",
+		"filter_object",
+	}
 )
 
+// QueryFilters maps filter names to the values applied to an API request.
+//
+// Deprecated: Use Filter, which validates its values against each endpoint.
 type QueryFilters map[string]string
 
-// APIError holds error information returned from an API request.
-type APIError struct {
-	status  bool   `json:"status"`
-	Message string `json:"error"`
-}
-
 type LaborStatsAPI struct {
-	Debug       bool
-	Filters     QueryFilters
+	// BaseURL overrides the default API location (https://data.dol.gov/get).
+	// Endpoint paths are appended to it, which allows requests to be sent to
+	// a caching proxy or a local test server.
+	BaseURL *url.URL
+	Debug   bool
+	// FetchConcurrency is the maximum number of endpoints FetchAll queries at
+	// once. If zero, 4 endpoints are queried at once.
+	FetchConcurrency int
+	Filters          QueryFilters
+	// HTTPClient is used to send API requests. If nil, http.DefaultClient is
+	// used.
+	HTTPClient *http.Client
+	// RateLimiter throttles requests. It may be shared between API instances.
+	// If nil, requests are not throttled.
+	RateLimiter *RateLimiter
+	// Deprecated: RawResponse is not populated. Responses are decoded by
+	// each query.
 	RawResponse []byte
-	SecretKey   string
-	endpoint    *url.URL
+	// Retry controls how failed requests are retried. If nil, requests are
+	// attempted once.
+	Retry     *RetryPolicy
+	SecretKey string
+	// Strict causes queries to fail with a *SchemaError when a response has
+	// keys which do not decode to a field, or lacks keys that a field
+	// expects. By default such keys are ignored or left zero.
+	Strict bool
+	filter *Filter
 }
 
-type QueryRunner interface {
-	sendRequest() error
-	unmarshalData() error
-}
-
-// lsbool is a custom boolean type for unmarshaling JSON
-type lsbool bool
-
 // NewLaborStatsAPI configures and returns a new API instance.
 func NewLaborStatsAPI(secretKey string) *LaborStatsAPI {
 	return &LaborStatsAPI{
@@ -66,219 +90,202 @@ func NewLaborStatsAPI(secretKey string) *LaborStatsAPI {
 // QueryAdvancementLevel submits an API request against the AdvancementLevel
 // endpoint.
 func (api *LaborStatsAPI) QueryAdvancementLevel() ([]AdvancementLevel, error) {
-	a := AdvancementLevelAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
+	return api.QueryAdvancementLevelContext(context.Background())
+}
 
-	return res, nil
+// QueryAdvancementLevelContext is like QueryAdvancementLevel but uses ctx to
+// control the lifetime of the API request.
+func (api *LaborStatsAPI) QueryAdvancementLevelContext(ctx context.Context) ([]AdvancementLevel, error) {
+	return NewQuery(api, AdvancementLevelEndpoint).Do(ctx)
 }
 
 // QueryCountry submits an API request against the Country endpoint.
 func (api *LaborStatsAPI) QueryCountry() ([]Country, error) {
-	a := CountryAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
+	return api.QueryCountryContext(context.Background())
+}
 
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
+// QueryCountryContext is like QueryCountry but uses ctx to control the lifetime
+// of the API request.
+func (api *LaborStatsAPI) QueryCountryContext(ctx context.Context) ([]Country, error) {
+	return NewQuery(api, CountryEndpoint).Do(ctx)
+}
 
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
+// QueryCountryData submits an API request against the Country Data endpoint,
+// which holds each country profile's legal framework: convention
+// ratifications, minimum ages and education standards.
+func (api *LaborStatsAPI) QueryCountryData() ([]CountryData, error) {
+	return api.QueryCountryDataContext(context.Background())
+}
 
-	return res, nil
+// QueryCountryDataContext is like QueryCountryData but uses ctx to control the
+// lifetime of the API request.
+func (api *LaborStatsAPI) QueryCountryDataContext(ctx context.Context) ([]CountryData, error) {
+	return NewQuery(api, CountryDataEndpoint).Do(ctx)
 }
 
-// QueryCountryGoods submits an API request against the Country Goods endpoint.
-func (api *LaborStatsAPI) QueryCountryGoods() ([]CountryGood, error) {
-	a := CountryGoodsAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
+// QueryCountryDataByProfile submits an API request against the Country Data
+// endpoint for the country profile with the given ID, in addition to any
+// filter already set.
+func (api *LaborStatsAPI) QueryCountryDataByProfile(profileID int) ([]CountryData, error) {
+	return api.QueryCountryDataByProfileContext(context.Background(), profileID)
+}
 
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
+// QueryCountryDataByProfileContext is like QueryCountryDataByProfile but uses
+// ctx to control the lifetime of the API request.
+func (api *LaborStatsAPI) QueryCountryDataByProfileContext(ctx context.Context, profileID int) ([]CountryData, error) {
+	f := api.filter.merge(NewFilter().Where("country_profile_id", profileID))
 
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
+	return NewQuery(api, CountryDataEndpoint).Filter(f).Do(ctx)
+}
 
-	return res, nil
+// QueryCountryGoods submits an API request against the Country Goods endpoint.
+func (api *LaborStatsAPI) QueryCountryGoods() ([]CountryGood, error) {
+	return api.QueryCountryGoodsContext(context.Background())
+}
 
+// QueryCountryGoodsContext is like QueryCountryGoods but uses ctx to control
+// the lifetime of the API request.
+func (api *LaborStatsAPI) QueryCountryGoodsContext(ctx context.Context) ([]CountryGood, error) {
+	return NewQuery(api, CountryGoodsEndpoint).Do(ctx)
 }
 
 // QueryCountryProfile submits an API request against the Country Profile
 // endpoint.
 func (api *LaborStatsAPI) QueryCountryProfile() ([]CountryProfile, error) {
-	a := CountryProfileAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return api.QueryCountryProfileContext(context.Background())
+}
 
+// QueryCountryProfileContext is like QueryCountryProfile but uses ctx to
+// control the lifetime of the API request.
+func (api *LaborStatsAPI) QueryCountryProfileContext(ctx context.Context) ([]CountryProfile, error) {
+	return NewQuery(api, CountryProfileEndpoint).Do(ctx)
 }
 
 // QueryCountryStats submits an API request against the Country Statistics
 // endpoint.
 func (api *LaborStatsAPI) QueryCountryStats() ([]CountryStat, error) {
-	a := CountryStatsAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
+	return api.QueryCountryStatsContext(context.Background())
+}
 
-	return res, nil
+// QueryCountryStatsContext is like QueryCountryStats but uses ctx to control
+// the lifetime of the API request.
+func (api *LaborStatsAPI) QueryCountryStatsContext(ctx context.Context) ([]CountryStat, error) {
+	return NewQuery(api, CountryStatsEndpoint).Do(ctx)
 }
 
 // QueryGood submits an API request against the "Good" endpoint.
 func (api *LaborStatsAPI) QueryGood() ([]Good, error) {
-	a := GoodAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
+	return api.QueryGoodContext(context.Background())
+}
 
-	return res, nil
+// QueryGoodContext is like QueryGood but uses ctx to control the lifetime of
+// the API request.
+func (api *LaborStatsAPI) QueryGoodContext(ctx context.Context) ([]Good, error) {
+	return NewQuery(api, GoodEndpoint).Do(ctx)
 }
 
 // QueryRegion submits an API request against the Region endpoint.
 func (api *LaborStatsAPI) QueryRegion() ([]Region, error) {
-	a := RegionAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return api.QueryRegionContext(context.Background())
+}
 
+// QueryRegionContext is like QueryRegion but uses ctx to control the lifetime
+// of the API request.
+func (api *LaborStatsAPI) QueryRegionContext(ctx context.Context) ([]Region, error) {
+	return NewQuery(api, RegionEndpoint).Do(ctx)
 }
 
 // QuerySector submits an API request against the Sector endpoint.
 func (api *LaborStatsAPI) QuerySector() ([]Sector, error) {
-	a := SectorAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return api.QuerySectorContext(context.Background())
+}
 
+// QuerySectorContext is like QuerySector but uses ctx to control the lifetime
+// of the API request.
+func (api *LaborStatsAPI) QuerySectorContext(ctx context.Context) ([]Sector, error) {
+	return NewQuery(api, SectorEndpoint).Do(ctx)
 }
 
 // QuerySuggestedActionArea submits an API request against the Suggested Action
 // Area endpoint.
 func (api *LaborStatsAPI) QuerySuggestedActionArea() ([]SuggestedActionArea, error) {
-	a := SuggestedActionAreaAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
-
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := a.unmarshalData()
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return api.QuerySuggestedActionAreaContext(context.Background())
+}
 
+// QuerySuggestedActionAreaContext is like QuerySuggestedActionArea but uses ctx
+// to control the lifetime of the API request.
+func (api *LaborStatsAPI) QuerySuggestedActionAreaContext(ctx context.Context) ([]SuggestedActionArea, error) {
+	return NewQuery(api, SuggestedActionAreaEndpoint).Do(ctx)
 }
 
 // QuerySuggestedActions submits an API request against the Suggested Actions
 // endpoint.
 func (api *LaborStatsAPI) QuerySuggestedActions() ([]SuggestedAction, error) {
-	a := SuggestedActionAPI{
-		Debug:     api.Debug,
-		SecretKey: api.SecretKey,
-	}
+	return api.QuerySuggestedActionsContext(context.Background())
+}
 
-	err := a.sendRequest()
-	if err != nil {
-		return nil, err
-	}
+// QuerySuggestedActionsContext is like QuerySuggestedActions but uses ctx to
+// control the lifetime of the API request.
+func (api *LaborStatsAPI) QuerySuggestedActionsContext(ctx context.Context) ([]SuggestedAction, error) {
+	return NewQuery(api, SuggestedActionEndpoint).Do(ctx)
+}
 
-	res, err := a.unmarshalData()
+// requestConfig returns a copy of the settings used by an endpoint request,
+// including a snapshot of the current filters.
+func (api *LaborStatsAPI) requestConfig() (LaborStatsAPI, error) {
+	f, err := filterFromMap(api.Filters)
 	if err != nil {
-		return nil, err
-	}
+		return LaborStatsAPI{}, err
+	}
+
+	return LaborStatsAPI{
+		BaseURL:     api.BaseURL,
+		Debug:       api.Debug,
+		HTTPClient:  api.HTTPClient,
+		RateLimiter: api.RateLimiter,
+		Retry:       api.Retry,
+		SecretKey:   api.SecretKey,
+		Strict:      api.Strict,
+		filter:      f.merge(api.filter),
+	}, nil
+}
 
-	return res, nil
+// WithFilter returns a copy of the API instance which applies f to its
+// queries in place of any Filter already set. The receiver is not modified,
+// so differently filtered queries can be run concurrently from the same base
+// instance. Fields referenced by f are validated against each endpoint when a
+// query is run.
+func (api *LaborStatsAPI) WithFilter(f *Filter) *LaborStatsAPI {
+	a := *api
+	a.Filters = api.Filters.clone()
+	a.RawResponse = nil
+	a.filter = f.clone()
+
+	return &a
+}
+
+// WithFilters returns a copy of the API instance which applies filters to
+// its queries in place of any filters already set. The receiver is not
+// modified, so differently filtered queries can be run concurrently from the
+// same base instance.
+//
+// Deprecated: Use WithFilter.
+func (api *LaborStatsAPI) WithFilters(filters QueryFilters) *LaborStatsAPI {
+	a := *api
+	a.Filters = filters.clone()
+	a.RawResponse = nil
+
+	return &a
 }
 
 // AddFilter adds a filter parameter to the API request.
-// The currently available filters are "limit", "start_date", "end_date",
-// and "order".
+// The currently available filters are "limit", "offset", "order", "sort_by",
+// "sort", "date_column", "start_date", "end_date", and "filter_object".
+//
+// Deprecated: Use WithFilter.
 func (api *LaborStatsAPI) AddFilter(filterName string, filterValue string) error {
 	if !filterIsValid(filterName) {
-		return invalidFilterError
+		return ErrInvalidFilter
 	}
 
 	if len(api.Filters) == 0 {
@@ -291,6 +298,15 @@ func (api *LaborStatsAPI) AddFilter(filterName string, filterValue string) error
 	return nil
 }
 
+func (f QueryFilters) clone() QueryFilters {
+	c := make(QueryFilters, len(f))
+	for key, val := range f {
+		c[key] = val
+	}
+
+	return c
+}
+
 func filterIsValid(filterKey string) bool {
 	for _, x := range validFilterKeys {
 		if x == filterKey {
@@ -301,43 +317,99 @@ func filterIsValid(filterKey string) bool {
 	return false
 }
 
-func buildEndpoint(path string, filterMap QueryFilters) *url.URL {
-	var filters []string
-
-	for key, val := range filterMap {
-		filters = append(filters, fmt.Sprintf("%s/%s", key, val))
+// buildEndpoint returns the request URL for path relative to baseURL, with
+// the parameters of f appended in canonical order. Fields referenced by f
+// must be among fields, unless fields is nil. The default API location is
+// used when baseURL is nil.
+func buildEndpoint(baseURL *url.URL, path string, fields []string, f *Filter) (*url.URL, error) {
+	if err := f.validate(path, fields); err != nil {
+		return nil, err
 	}
 
-	queryPath := fmt.Sprintf("%s/%s", apiPath, path)
+	segments := append([]string{path}, f.segments()...)
 
-	if len(filters) > 0 {
-		queryPath = fmt.Sprintf("%s/%s/%s", apiPath, path, strings.Join(filters, "/"))
+	var escaped []string
+	for _, seg := range segments {
+		escaped = append(escaped, url.PathEscape(seg))
 	}
 
 	url := &url.URL{
 		Scheme: apiScheme,
 		Host:   apiHost,
-		Path:   queryPath,
+		Path:   "/" + apiPath,
 	}
 
-	return url
+	if baseURL != nil {
+		*url = *baseURL
+	}
+
+	basePath := strings.TrimSuffix(url.EscapedPath(), "/")
+
+	url.Path = fmt.Sprintf("%s/%s", strings.TrimSuffix(url.Path, "/"), strings.Join(segments, "/"))
+	url.RawPath = fmt.Sprintf("%s/%s", basePath, strings.Join(escaped, "/"))
+
+	return url, nil
 }
 
-func doRequest(endpointURL string, secretKey string, debug bool) ([]byte, error) {
-	if debug {
+// doRequest sends a GET request to endpointURL, the request URL for the
+// endpoint at path, and returns the response body. Failed requests are
+// retried according to api.Retry.
+func (api *LaborStatsAPI) doRequest(ctx context.Context, path string, endpointURL string) ([]byte, error) {
+	if api.Debug {
 		log.Printf("API endpoint URL: %s", endpointURL)
 	}
 
-	client := &http.Client{}
+	method := http.MethodGet
+
+	if api.Retry == nil {
+		return api.sendAttempt(ctx, method, path, endpointURL)
+	}
 
-	req, err := http.NewRequest("GET", endpointURL, nil)
+	policy := api.Retry.normalize()
+
+	for attempt := 1; ; attempt++ {
+		body, err := api.sendAttempt(ctx, method, path, endpointURL)
+		if err == nil {
+			return body, nil
+		}
+
+		if attempt >= policy.MaxAttempts || !isIdempotent(method) || !isRetryable(ctx, err) {
+			return nil, &RetryError{Attempts: attempt, Err: err}
+		}
+
+		delay := policy.backoff(attempt, retryAfter(err))
+
+		if api.Debug {
+			log.Printf("Attempt %d failed, retrying in %s: %s", attempt, delay, err)
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, &RetryError{Attempts: attempt, Err: err}
+		}
+	}
+}
+
+// sendAttempt performs a single HTTP request and validates the response.
+func (api *LaborStatsAPI) sendAttempt(ctx context.Context, method string, path string, endpointURL string) ([]byte, error) {
+	if api.RateLimiter != nil {
+		if err := api.RateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	client := api.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpointURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add(secretKeyHeader, secretKey)
+	req.Header.Add(secretKeyHeader, api.SecretKey)
 
-	if debug {
+	if api.Debug {
 		log.Printf("HTTP request headers: %v", req.Header)
 	}
 
@@ -353,42 +425,96 @@ func doRequest(endpointURL string, secretKey string, debug bool) ([]byte, error)
 		return nil, err
 	}
 
-	if debug {
+	if api.Debug {
 		log.Printf("Response body: %v", string(body))
 	}
 
-	apiErr := unmarshalErrorResponse(body)
-	if apiErr != nil {
-		return nil, apiErr
+	apiErr := classifyResponse(resp, body)
+	if apiErr == nil {
+		return body, nil
 	}
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("%s HTTP status code returned was: %d.", invalidResponseError, resp.StatusCode)
-	}
+	apiErr.Endpoint = path
+	apiErr.RequestURL = endpointURL
+	apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
 
-	return body, nil
+	return nil, apiErr
 }
 
-// unmarshalErrorResponse attempts to unmarshal an API error message if one
-// exists. If an error is found, the details are returned.
-func unmarshalErrorResponse(b []byte) error {
-	apiErr := APIError{}
+// classifyResponse returns an error describing resp unless it is a
+// successful JSON result. The status code and Content-Type are checked before
+// the body is inspected for an API error message.
+func classifyResponse(resp *http.Response, body []byte) *APIError {
+	contentType := resp.Header.Get("Content-Type")
+	isJSON := isJSONResponse(contentType, body)
 
-	err := json.Unmarshal(b, &apiErr)
+	var apiErr *APIError
+	if isJSON {
+		apiErr = unmarshalErrorResponse(body)
+	}
+
+	if apiErr == nil {
+		if isJSON && resp.StatusCode == http.StatusOK {
+			return nil
+		}
+
+		apiErr = &APIError{Body: bodySnippet(body)}
+	}
+
+	apiErr.StatusCode = resp.StatusCode
+	apiErr.ContentType = contentType
+
+	return apiErr
+}
+
+// isJSONResponse reports whether a response holds JSON. Responses without a
+// usable Content-Type are sniffed.
+func isJSONResponse(contentType string, body []byte) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err == nil {
-		return fmt.Errorf("%s The error message was: %+s", LaborStatsAPIError, apiErr.Message)
+		if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+			return true
+		}
+
+		if mediaType != "text/plain" && mediaType != "application/octet-stream" {
+			return false
+		}
 	}
 
-	return nil
+	trimmed := bytes.TrimSpace(body)
+
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
 }
 
-// UnmarshalJSON is a custom implementation of the UnmarshalJSON interface for
-// values returned from the Labor Stats API. The JSON returned by the API to
-// represent boolean values is 0 or 1.
-func (bv *lsbool) UnmarshalJSON(b []byte) error {
-	boolVal := string(b) == "1"
+// bodySnippet returns the start of a response body for use in an error
+// message.
+func bodySnippet(body []byte) string {
+	snippet := strings.TrimSpace(string(body))
 
-	*bv = lsbool(boolVal)
+	if len(snippet) > maxBodySnippet {
+		snippet = strings.ToValidUTF8(snippet[:maxBodySnippet], "") + "..."
+	}
 
-	return nil
+	return snippet
+}
+
+// unmarshalErrorResponse attempts to unmarshal an API error message if one
+// exists. Only a JSON object with an "error" key, and a "status" key that is
+// absent or false, is treated as an error.
+func unmarshalErrorResponse(b []byte) *APIError {
+	var envelope struct {
+		Status *bool   `json:"status"`
+		Error  *string `json:"error"`
+	}
+
+	err := json.Unmarshal(b, &envelope)
+	if err != nil || envelope.Error == nil {
+		return nil
+	}
+
+	if envelope.Status != nil && *envelope.Status {
+		return nil
+	}
+
+	return &APIError{Message: *envelope.Error}
 }
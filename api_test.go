@@ -1,8 +1,14 @@
 package laborstats
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -17,13 +23,45 @@ func getDataMock(mockPath string) ([]byte, error) {
 	return dataMock, nil
 }
 
+// newTestAPI returns an API instance which sends its requests to a test
+// server running handler, and a function which shuts the server down.
+func newTestAPI(t *testing.T, handler http.Handler) (*LaborStatsAPI, func()) {
+	ts := httptest.NewServer(handler)
+
+	baseURL, err := url.Parse(ts.URL)
+	if err != nil {
+		ts.Close()
+		t.Fatal(err)
+	}
+
+	a := NewLaborStatsAPI(testAPIKey)
+	a.BaseURL = baseURL
+
+	return a, ts.Close
+}
+
+// roundTripFunc is an http.RoundTripper implemented by a function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
 func TestAddFilter(t *testing.T) {
 	a := LaborStatsAPI{}
 	testPath := "myPath"
 
 	a.AddFilter("limit", "10")
 
-	endpoint := buildEndpoint(testPath, a.Filters)
+	f, err := filterFromMap(a.Filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, err := buildEndpoint(nil, testPath, nil, f)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if endpoint.String() != fmt.Sprintf("%s://%s/%s/%s/%s/%s", apiScheme, apiHost, apiPath, testPath, "limit", "10") {
 		t.Error("Invalid endpoint built: ", endpoint.String())
@@ -40,10 +78,178 @@ func TestFilterIsValid(t *testing.T) {
 
 func TestBuildEndpoint(t *testing.T) {
 	testPath := "myPath"
-	testFilters := map[string]string{}
-	endpoint := buildEndpoint(testPath, testFilters)
+	endpoint, err := buildEndpoint(nil, testPath, nil, NewFilter())
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	if endpoint.String() != fmt.Sprintf("%s://%s/%s/%s", apiScheme, apiHost, apiPath, testPath) {
 		t.Error("Invalid endpoint built: ", endpoint.String())
 	}
 }
+
+func TestDoRequestCanceledContext(t *testing.T) {
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Request sent despite canceled context.")
+	}))
+	defer stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := a.doRequest(ctx, "myPath", a.BaseURL.String())
+	if !errors.Is(err, context.Canceled) {
+		t.Error("Expected context.Canceled error, got: ", err)
+	}
+}
+
+func TestBuildEndpointBaseURL(t *testing.T) {
+	baseURL, err := url.Parse("http://localhost:8080/proxy/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, err := buildEndpoint(baseURL, "myPath", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if endpoint.String() != "http://localhost:8080/proxy/myPath" {
+		t.Error("Invalid endpoint built: ", endpoint.String())
+	}
+
+	if baseURL.Path != "/proxy/" {
+		t.Error("Base URL modified: ", baseURL.String())
+	}
+}
+
+func TestQueryCustomBaseURLAndClient(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/country.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/get/"+countryURI {
+			t.Error("Invalid request path: ", r.URL.Path)
+		}
+		if r.Header.Get(secretKeyHeader) != testAPIKey {
+			t.Error("Invalid secret key header: ", r.Header.Get(secretKeyHeader))
+		}
+		w.Write(dataMock)
+	}))
+	defer stop()
+
+	a.BaseURL = a.BaseURL.JoinPath("get")
+
+	var clientUsed bool
+	a.HTTPClient = &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			clientUsed = true
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	result, err := a.QueryCountry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !clientUsed {
+		t.Error("Custom HTTP client not used.")
+	}
+
+	if len(result) != 2 {
+		t.Error("Invalid result length: ", len(result))
+	}
+}
+
+func TestQueryAppliesFilters(t *testing.T) {
+	var paths []string
+
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte("[]"))
+	}))
+	defer stop()
+
+	a.AddFilter("limit", "10")
+
+	if _, err := a.QueryGood(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.WithFilters(QueryFilters{"limit": "5"}).QueryRegion(); err != nil {
+		t.Fatal(err)
+	}
+
+	if paths[0] != "/"+goodURI+"/limit/10" {
+		t.Error("Filters not applied: ", paths[0])
+	}
+
+	if paths[1] != "/"+regionURI+"/limit/5" {
+		t.Error("Per-call filters not applied: ", paths[1])
+	}
+
+	if a.Filters["limit"] != "10" {
+		t.Error("WithFilters modified the original filters: ", a.Filters)
+	}
+}
+
+func TestQueryInvalidFilter(t *testing.T) {
+	a := NewLaborStatsAPI(testAPIKey)
+
+	_, err := a.WithFilters(QueryFilters{"not_a_filter": "1"}).QueryCountry()
+	if err == nil {
+		t.Error("Invalid filter accepted.")
+	}
+}
+
+func TestClassifyResponse(t *testing.T) {
+	html := "<html><body>" + strings.Repeat("Bad Gateway ", 100) + "</body></html>"
+
+	cases := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		isErr       bool
+		message     string
+	}{
+		{"json array", 200, "application/json", `[{"id": 1}]`, false, ""},
+		{"single object", 200, "application/json", `{"id": 1, "name": "Bricks"}`, false, ""},
+		{"sniffed json", 200, "", `[]`, false, ""},
+		{"successful envelope", 200, "application/json", `{"status": true, "error": ""}`, false, ""},
+		{"error envelope", 200, "application/json", `{"status": false, "error": "Invalid API Key "}`, true, "Invalid API Key "},
+		{"html page", 200, "text/html", html, true, ""},
+		{"gateway error", 502, "text/html; charset=utf-8", html, true, ""},
+		{"json status error", 500, "application/json", `[]`, true, ""},
+	}
+
+	for _, c := range cases {
+		resp := &http.Response{StatusCode: c.status, Header: http.Header{}}
+		resp.Header.Set("Content-Type", c.contentType)
+
+		apiErr := classifyResponse(resp, []byte(c.body))
+		if (apiErr != nil) != c.isErr {
+			t.Errorf("%s: unexpected classification: %v", c.name, apiErr)
+			continue
+		}
+
+		if apiErr == nil {
+			continue
+		}
+
+		if apiErr.Message != c.message {
+			t.Errorf("%s: invalid Message: %q", c.name, apiErr.Message)
+		}
+
+		if c.message == "" && !strings.HasPrefix(html, strings.TrimSuffix(apiErr.Body, "...")) && apiErr.Body != c.body {
+			t.Errorf("%s: invalid Body: %q", c.name, apiErr.Body)
+		}
+
+		if len(apiErr.Body) > maxBodySnippet+len("...") {
+			t.Errorf("%s: Body not truncated: %d bytes", c.name, len(apiErr.Body))
+		}
+	}
+}
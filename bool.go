@@ -0,0 +1,99 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// BoolState is the value of a Bool, which may be unknown.
+type BoolState int
+
+const (
+	BoolUnknown BoolState = iota
+	BoolTrue
+	BoolFalse
+)
+
+func (s BoolState) String() string {
+	switch s {
+	case BoolTrue:
+		return "true"
+	case BoolFalse:
+		return "false"
+	}
+
+	return "unknown"
+}
+
+// Bool is a boolean decoded from the API. The API encodes booleans in
+// several ways, including 1 and 0, true and false, and the strings "1",
+// "Yes", "Y" and "true" and their negations; null and empty strings decode
+// as BoolUnknown.
+type Bool struct {
+	State BoolState
+	// Raw is the JSON the API sent, e.g. `1` or `"Yes"`.
+	Raw string
+}
+
+// True reports whether the value is known to be true.
+func (b Bool) True() bool {
+	return b.State == BoolTrue
+}
+
+// Known reports whether the value is true or false.
+func (b Bool) Known() bool {
+	return b.State != BoolUnknown
+}
+
+func (b Bool) String() string {
+	return b.State.String()
+}
+
+// UnmarshalJSON decodes a boolean from any of the encodings used by the API.
+// Values which are not recognised return an error.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	raw := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+	}
+
+	var state BoolState
+
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "1", "true", "yes", "y":
+		state = BoolTrue
+	case "0", "false", "no", "n":
+		state = BoolFalse
+	case "null", "":
+		state = BoolUnknown
+	default:
+		return fmt.Errorf("Invalid boolean: %s", data)
+	}
+
+	*b = Bool{State: state, Raw: string(data)}
+
+	return nil
+}
+
+// MarshalJSON writes Raw if it still decodes to State, and otherwise 1, 0
+// or null as the API usually does.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if rawMatches(b, b.Raw) {
+		return []byte(b.Raw), nil
+	}
+
+	switch b.State {
+	case BoolTrue:
+		return []byte("1"), nil
+	case BoolFalse:
+		return []byte("0"), nil
+	}
+
+	return []byte("null"), nil
+}
@@ -0,0 +1,86 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolUnmarshalJSON(t *testing.T) {
+	cases := map[string]BoolState{
+		`1`:       BoolTrue,
+		`"1"`:     BoolTrue,
+		`true`:    BoolTrue,
+		`"true"`:  BoolTrue,
+		`"Yes"`:   BoolTrue,
+		`"Y"`:     BoolTrue,
+		`0`:       BoolFalse,
+		`"0"`:     BoolFalse,
+		`false`:   BoolFalse,
+		`"False"`: BoolFalse,
+		`"No"`:    BoolFalse,
+		`"n"`:     BoolFalse,
+		`null`:    BoolUnknown,
+		`""`:      BoolUnknown,
+	}
+
+	for in, expected := range cases {
+		b := Bool{State: BoolTrue}
+		if err := json.Unmarshal([]byte(in), &b); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if b != (Bool{expected, in}) {
+			t.Errorf("Unmarshal(%s) = %+v, expected %s", in, b, expected)
+		}
+	}
+
+	for _, in := range []string{`2`, `"maybe"`, `[]`, `{}`} {
+		var b Bool
+		if err := json.Unmarshal([]byte(in), &b); err == nil {
+			t.Errorf("Unmarshal(%s) accepted invalid boolean.", in)
+		}
+	}
+}
+
+func TestBoolMarshalJSON(t *testing.T) {
+	for _, state := range []BoolState{BoolTrue, BoolFalse, BoolUnknown} {
+		b := Bool{State: state}
+
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Bool
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.State != state {
+			t.Errorf("Marshal(%s) = %s, decoded as %s", b, data, decoded)
+		}
+	}
+
+	data, err := json.Marshal([]Bool{{State: BoolTrue}, {State: BoolFalse}, {}})
+	if err != nil || string(data) != `[1,0,null]` {
+		t.Error("Invalid marshaled booleans: ", string(data), err)
+	}
+
+	var decoded []Bool
+	if err := json.Unmarshal([]byte(`["Yes",false,null]`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err = json.Marshal(decoded)
+	if err != nil || string(data) != `["Yes",false,null]` {
+		t.Error("Raw booleans not kept: ", string(data), err)
+	}
+
+	decoded[0].State = BoolFalse
+
+	data, err = json.Marshal(decoded)
+	if err != nil || string(data) != `[0,false,null]` {
+		t.Error("Edited boolean not encoded: ", string(data), err)
+	}
+}
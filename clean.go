@@ -0,0 +1,194 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"time"
+	"unicode"
+)
+
+// cleanMarshaler is implemented by decoded API values which have a simpler
+// representation in the clean schema.
+type cleanMarshaler interface {
+	cleanJSON() interface{}
+}
+
+// MarshalClean encodes v, which may be a model, a slice of models or a
+// *Dataset, in a clean schema rather than the API's wire format. Keys are the
+// snake_case Go field names, e.g. "min_work_age" rather than
+// "minimum_age_for_work", and values have their natural JSON types:
+//
+//	Bool, RatificationStatus  true, false or null
+//	Age                       the age in years, or null if not established
+//	NullFloat, NullInt        a number, or null
+//	YearRange                 {"start": 2010, "end": 2011}, or null
+//	AgeRange                  {"min": 5, "max": 14}, or null
+//
+// The clean schema cannot be decoded back into the models; use json.Marshal
+// to encode values that must round-trip.
+func MarshalClean(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := writeClean(&buf, reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func writeClean(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() || ((v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil()) {
+		buf.WriteString("null")
+		return nil
+	}
+
+	if c, ok := v.Interface().(cleanMarshaler); ok {
+		return writeJSON(buf, c.cleanJSON())
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return writeClean(buf, v.Elem())
+	case reflect.Slice, reflect.Array:
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeClean(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+		return nil
+	case reflect.Struct:
+		if v.Type() == timeType {
+			break
+		}
+
+		buf.WriteByte('{')
+		first := true
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+
+			if err := writeJSON(buf, snakeCase(f.Name)); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeClean(buf, v.Field(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+
+		return nil
+	}
+
+	return writeJSON(buf, v.Interface())
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	buf.Write(b)
+
+	return nil
+}
+
+// snakeCase converts a Go identifier to snake_case, keeping initialisms
+// together, e.g. "CWASAgeRange" becomes "cwas_age_range".
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var out []rune
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				out = append(out, '_')
+			}
+		}
+		out = append(out, unicode.ToLower(r))
+	}
+
+	return string(out)
+}
+
+func (b Bool) cleanJSON() interface{} {
+	if !b.Known() {
+		return nil
+	}
+
+	return b.True()
+}
+
+func (s RatificationStatus) cleanJSON() interface{} {
+	if s.State == RatificationUnknown {
+		return nil
+	}
+
+	return s.Ratified()
+}
+
+func (a Age) cleanJSON() interface{} {
+	if years, ok := a.Value(); ok {
+		return years
+	}
+
+	return nil
+}
+
+func (n NullFloat) cleanJSON() interface{} {
+	if !n.Valid {
+		return nil
+	}
+
+	return n.Float64
+}
+
+func (n NullInt) cleanJSON() interface{} {
+	if !n.Valid {
+		return nil
+	}
+
+	return n.Int
+}
+
+func (r YearRange) cleanJSON() interface{} {
+	if r.Missing() {
+		return nil
+	}
+
+	return struct {
+		Start int `json:"start"`
+		End   int `json:"end"`
+	}{r.Start, r.End}
+}
+
+func (r AgeRange) cleanJSON() interface{} {
+	if r.Missing() {
+		return nil
+	}
+
+	return struct {
+		Min int `json:"min"`
+		Max int `json:"max"`
+	}{r.Min, r.Max}
+}
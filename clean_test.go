@@ -0,0 +1,84 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalClean(t *testing.T) {
+	var stat CountryStat
+	err := json.Unmarshal([]byte(`{
+		"country_profile_id": 1,
+		"cws_age_range": "5-14",
+		"cws_total_percentage_of_workin": 7.5,
+		"cws_total_working_population": null,
+		"esas_year": "2010-11",
+		"upcr_year": "0000",
+		"upcr_rate": 0
+	}`), &stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := MarshalClean(stat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"country_profile_id":1,"cw_age_range":{"min":5,"max":14},"cw_percent":7.5,` +
+		`"cw_population":null,"cw_agriculture":null,"cw_service":null,"cw_industry":null,` +
+		`"school_att_year":{"start":2010,"end":2011},"school_att_age_range":null,"school_att_percent":null,` +
+		`"cwas_year":null,"cwas_age_range":null,"cwas_total":null,"pcr_year":null,"pcr_rate":null}`
+	if string(b) != expected {
+		t.Error("Invalid clean CountryStat: ", string(b))
+	}
+
+	goods := []CountryGood{{CountryProfileID: 1, GoodID: 2, ChildLabor: Bool{State: BoolTrue}, ForcedLabor: Bool{State: BoolFalse}}}
+
+	b, err = MarshalClean(goods)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = `[{"country_profile_id":1,"good_id":2,"child_labor":true,"forced_labor":false,"forced_child_labor":null}]`
+	if string(b) != expected {
+		t.Error("Invalid clean CountryGood: ", string(b))
+	}
+
+	data := &CountryData{
+		C138Ratified: RatificationStatus{Ratified, "Yes"},
+		MinWorkAge:   Age{15, AgeEstablished, "15"},
+		CompEdAge:    Age{0, AgeNotEstablished, "No"},
+	}
+
+	b, err = MarshalClean(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var clean map[string]interface{}
+	if err := json.Unmarshal(b, &clean); err != nil {
+		t.Fatal(err)
+	}
+
+	if clean["c138_ratified"] != true || clean["min_work_age"] != 15.0 || clean["comp_ed_age"] != nil {
+		t.Error("Invalid clean CountryData: ", string(b))
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"ID":                       "id",
+		"ISO2":                     "iso2",
+		"AdLevelID":                "ad_level_id",
+		"CWASAgeRange":             "cwas_age_range",
+		"C138Ratified":             "c138_ratified",
+		"CRCCSARatificationStatus": "crccsa_ratification_status",
+	}
+
+	for in, expected := range cases {
+		if s := snakeCase(in); s != expected {
+			t.Errorf("snakeCase(%s) = %s, expected %s", in, s, expected)
+		}
+	}
+}
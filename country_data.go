@@ -2,48 +2,128 @@ package laborstats
 
 import "encoding/json"
 
+// Minimum age for hazardous work set by ILO C. 182 and its recommendation.
+const hazardousWorkAgeStandard = 18
+
 // Request path for Country Data data.
 const countryDataURI = "childlabor_mas"
 
-type CountryDataAPI LaborStatsAPI
+// CountryDataEndpoint describes the Country Data endpoint.
+var CountryDataEndpoint = Endpoint[CountryData]{Path: countryDataURI}
 
 type CountryData struct {
-	CountryProfileID          int    `json:"country_profile_id"`
-	C138Ratified              string `json:"c_138_ratified,omitempty"`
-	C182Ratified              string `json:"c_182_ratified,omitempty"`
-	CRCRatificationStatus     string `json:"convention_on_the_rights_of_th,omitempty"`
-	CRCCSARatificationStatus  string `json:"crc_commercial_sexual_exploita,omitempty"`
-	CRCACRatificationStatus   string `json:"crc_armed_conflict_ratified,omitempty"`
-	PalermoRatificationStatus string `json:"palermo_ratified,omitempty"`
-	MinWorkAgeStatus          string `json:"minimum_age_for_work_establish,omitempty"`
-	MinWorkAge                string `json:"minimum_age_for_work,omitempty"`
-	MinHazWorkAgeStatus       string `json:"minimum_age_for_hazardous_work_established,omitempty"`
-	MinHazWorkAge             string `json:"minimum_age_for_hazardous_work,omitempty"`
-	CompEdAgeStatus           string `json:"compulsory_education_age_estab,omitempty"`
-	CompEdAge                 string `json:"minimum_age_for_compulsory_edu",omitempty`
-	FreePubEdStatus           string `json:"free_public_education_establis,omitepty"`
-}
-
-func (api *CountryDataAPI) sendRequest() error {
-	api.endpoint = buildEndpoint(countryDataURI, api.Filters)
-
-	rawResponse, err := doRequest(api.endpoint.String(), api.SecretKey, api.Debug)
+	CountryProfileID          int                `json:"country_profile_id"`
+	C138Ratified              RatificationStatus `json:"c_138_ratified,omitempty"`
+	C182Ratified              RatificationStatus `json:"c_182_ratified,omitempty"`
+	CRCRatificationStatus     RatificationStatus `json:"convention_on_the_rights_of_th,omitempty"`
+	CRCCSARatificationStatus  RatificationStatus `json:"crc_commercial_sexual_exploita,omitempty"`
+	CRCACRatificationStatus   RatificationStatus `json:"crc_armed_conflict_ratified,omitempty"`
+	PalermoRatificationStatus RatificationStatus `json:"palermo_ratified,omitempty"`
+	MinWorkAgeStatus          string             `json:"minimum_age_for_work_establish,omitempty"`
+	MinWorkAge                Age                `json:"minimum_age_for_work,omitempty"`
+	MinHazWorkAgeStatus       string             `json:"minimum_age_for_hazardous_work_established,omitempty"`
+	MinHazWorkAge             Age                `json:"minimum_age_for_hazardous_work,omitempty"`
+	CompEdAgeStatus           string             `json:"compulsory_education_age_estab,omitempty"`
+	CompEdAge                 Age                `json:"minimum_age_for_compulsory_edu,omitempty"`
+	FreePubEdStatus           string             `json:"free_public_education_establis,omitempty"`
+}
+
+// UnmarshalJSON decodes country data, applying each "established" flag to
+// the corresponding age.
+func (d *CountryData) UnmarshalJSON(b []byte) error {
+	type countryData CountryData
+
+	err := json.Unmarshal(b, (*countryData)(d))
 	if err != nil {
 		return err
 	}
 
-	api.RawResponse = rawResponse
+	d.MinWorkAge = d.MinWorkAge.withStatus(d.MinWorkAgeStatus)
+	d.MinHazWorkAge = d.MinHazWorkAge.withStatus(d.MinHazWorkAgeStatus)
+	d.CompEdAge = d.CompEdAge.withStatus(d.CompEdAgeStatus)
 
 	return nil
 }
 
-func (api *CountryDataAPI) unmarshalData() ([]CountryData, error) {
-	var countryData []CountryData
+// MarshalJSON encodes country data in the API's format, leaving out the
+// ratifications and ages that were absent from the response.
+func (d CountryData) MarshalJSON() ([]byte, error) {
+	type countryData CountryData
 
-	err := json.Unmarshal(api.RawResponse, &countryData)
-	if err != nil {
-		return nil, err
+	return marshalOmitZero(countryData(d))
+}
+
+// CompEdBelowMinWorkAge reports whether compulsory education ends before
+// children may legally work, leaving them vulnerable to child labor. It is
+// false if either age is not established.
+func (d CountryData) CompEdBelowMinWorkAge() bool {
+	return ageBelow(d.CompEdAge, d.MinWorkAge)
+}
+
+// HazWorkAgeBelowMinWorkAge reports whether the minimum age for hazardous
+// work is below the general minimum age for work. It is false if either age
+// is not established.
+func (d CountryData) HazWorkAgeBelowMinWorkAge() bool {
+	return ageBelow(d.MinHazWorkAge, d.MinWorkAge)
+}
+
+// HazWorkAgeBelowStandard reports whether the minimum age for hazardous work
+// is below the international standard of 18. It is false if the age is not
+// established.
+func (d CountryData) HazWorkAgeBelowStandard() bool {
+	years, ok := d.MinHazWorkAge.Value()
+
+	return ok && years < hazardousWorkAgeStandard
+}
+
+// ageBelow reports whether a and b are both established and a is below b.
+func ageBelow(a, b Age) bool {
+	aYears, aOK := a.Value()
+	bYears, bOK := b.Value()
+
+	return aOK && bOK && aYears < bYears
+}
+
+// Ratifications returns the ratification status of each tracked convention.
+func (d CountryData) Ratifications() map[Convention]RatificationStatus {
+	return map[Convention]RatificationStatus{
+		ConventionC138:    d.C138Ratified,
+		ConventionC182:    d.C182Ratified,
+		ConventionCRC:     d.CRCRatificationStatus,
+		ConventionCRCCSA:  d.CRCCSARatificationStatus,
+		ConventionCRCAC:   d.CRCACRatificationStatus,
+		ConventionPalermo: d.PalermoRatificationStatus,
+	}
+}
+
+// Unratified returns the conventions the country is known not to have
+// ratified. Conventions whose status is unknown are not included.
+func (d CountryData) Unratified() []Convention {
+	return d.conventionsIn(NotRatified)
+}
+
+// UnknownRatifications returns the conventions whose ratification status is
+// unknown.
+func (d CountryData) UnknownRatifications() []Convention {
+	return d.conventionsIn(RatificationUnknown)
+}
+
+func (d CountryData) conventionsIn(state Ratification) []Convention {
+	var conventions []Convention
+
+	ratifications := d.Ratifications()
+	for _, c := range []Convention{
+		ConventionC138,
+		ConventionC182,
+		ConventionCRC,
+		ConventionCRCCSA,
+		ConventionCRCAC,
+		ConventionPalermo,
+	} {
+		if ratifications[c].State == state {
+			conventions = append(conventions, c)
+		}
 	}
 
-	return countryData, nil
+	return conventions
 }
@@ -1,6 +1,12 @@
 package laborstats
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
 
 func TestCountryDataUnmarshalData(t *testing.T) {
 	dataMock, err := getDataMock("./testdata/country_data.json")
@@ -8,10 +14,7 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := CountryDataAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := CountryDataEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -26,27 +29,27 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error("Invalid CountryProfileID value: ", fRes.CountryProfileID)
 	}
 
-	if fRes.C138Ratified != "Yes" {
+	if fRes.C138Ratified != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid C138Ratified value.")
 	}
 
-	if fRes.C182Ratified != "Yes" {
+	if fRes.C182Ratified != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid C182Ratified value.")
 	}
 
-	if fRes.CRCRatificationStatus != "Yes" {
+	if fRes.CRCRatificationStatus != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid CRCRatificationStatus value.")
 	}
 
-	if fRes.CRCCSARatificationStatus != "Yes" {
+	if fRes.CRCCSARatificationStatus != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid CRCCSARatificationStatus value.")
 	}
 
-	if fRes.CRCACRatificationStatus != "Yes" {
+	if fRes.CRCACRatificationStatus != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid CRCACRatificationStatus value.")
 	}
 
-	if fRes.PalermoRatificationStatus != "Yes" {
+	if fRes.PalermoRatificationStatus != (RatificationStatus{Ratified, `"Yes"`}) {
 		t.Error("Invalid PalermoRatificationStatus value.")
 	}
 
@@ -54,7 +57,7 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error("Invalid MinWorkAgeStatus value.")
 	}
 
-	if fRes.MinWorkAge != "15" {
+	if fRes.MinWorkAge != (Age{15, AgeEstablished, `"15"`}) {
 		t.Error("Invalid MinWorkAge value.")
 	}
 
@@ -62,7 +65,7 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error("Invalid MinHazWorkAgeStatus value.")
 	}
 
-	if fRes.MinHazWorkAge != "18" {
+	if fRes.MinHazWorkAge != (Age{18, AgeEstablished, `"18"`}) {
 		t.Error("Invalid MinHazWorkAge value.")
 	}
 
@@ -70,7 +73,7 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error("Invalid CompEdAgeStatus value.")
 	}
 
-	if fRes.CompEdAge != "15" {
+	if fRes.CompEdAge != (Age{15, AgeEstablished, `"15"`}) {
 		t.Error("Invalid CompEdAge value.")
 	}
 
@@ -78,3 +81,115 @@ func TestCountryDataUnmarshalData(t *testing.T) {
 		t.Error("Invalid FreePubEdStatus value.")
 	}
 }
+
+func TestQueryCountryDataByProfile(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/country_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(dataMock)
+	}))
+	defer stop()
+
+	result, err := a.QueryCountryData()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 2 {
+		t.Error("Invalid result length: ", len(result))
+	}
+
+	_, err = a.WithFilter(NewFilter().Limit(1)).QueryCountryDataByProfileContext(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = a.QueryCountryDataByProfile(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"/" + countryDataURI,
+		"/" + countryDataURI + "/limit/1/filter_object/" + `{"field":"country_profile_id","operator":"eq","value":"2"}`,
+		"/" + countryDataURI + "/filter_object/" + `{"field":"country_profile_id","operator":"eq","value":"1"}`,
+	}
+
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Error("Invalid request paths: ", paths)
+	}
+}
+
+func TestCountryDataUnratified(t *testing.T) {
+	var d CountryData
+	err := json.Unmarshal([]byte(`{
+		"c_138_ratified": "Yes",
+		"c_182_ratified": "No",
+		"convention_on_the_rights_of_th": "Yes",
+		"crc_commercial_sexual_exploita": "N/A",
+		"crc_armed_conflict_ratified": "No",
+		"palermo_ratified": "Yes"
+	}`), &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unratified := d.Unratified()
+	if len(unratified) != 2 || unratified[0] != ConventionC182 || unratified[1] != ConventionCRCAC {
+		t.Error("Invalid unratified conventions: ", unratified)
+	}
+
+	unknown := d.UnknownRatifications()
+	if len(unknown) != 1 || unknown[0] != ConventionCRCCSA {
+		t.Error("Invalid unknown ratifications: ", unknown)
+	}
+}
+
+func TestCountryDataAgeChecks(t *testing.T) {
+	var d CountryData
+	err := json.Unmarshal([]byte(`{
+		"minimum_age_for_work_establish": "Yes",
+		"minimum_age_for_work": "15",
+		"minimum_age_for_hazardous_work_established": "Yes",
+		"minimum_age_for_hazardous_work": "16",
+		"compulsory_education_age_estab": "Yes",
+		"minimum_age_for_compulsory_edu": "12"
+	}`), &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.CompEdBelowMinWorkAge() {
+		t.Error("Compulsory education age below minimum work age not detected.")
+	}
+	if d.HazWorkAgeBelowMinWorkAge() {
+		t.Error("Hazardous work age incorrectly below minimum work age.")
+	}
+	if !d.HazWorkAgeBelowStandard() {
+		t.Error("Hazardous work age below standard not detected.")
+	}
+
+	d = CountryData{}
+	err = json.Unmarshal([]byte(`{
+		"minimum_age_for_work_establish": "No",
+		"minimum_age_for_work": "14",
+		"compulsory_education_age_estab": "Yes",
+		"minimum_age_for_compulsory_edu": "12"
+	}`), &d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.MinWorkAge.State != AgeNotEstablished {
+		t.Error("Established flag not applied: ", d.MinWorkAge)
+	}
+	if d.CompEdBelowMinWorkAge() || d.HazWorkAgeBelowStandard() {
+		t.Error("Checks reported for ages that are not established.")
+	}
+}
@@ -1,40 +1,21 @@
 package laborstats
 
-import "encoding/json"
-
 // Request path for Country Goods data.
 const countryGoodsURI = "childlabor_cty_goo"
 
-type CountryGoodsAPI LaborStatsAPI
+// CountryGoodsEndpoint describes the Country Goods endpoint.
+var CountryGoodsEndpoint = Endpoint[CountryGood]{Path: countryGoodsURI}
 
 type CountryGood struct {
-	CountryProfileID int    `json:"country_profile_id,omitempty"`
-	GoodID           int    `json:"good_id,omitempty"`
-	ChildLabor       lsbool `json:"child_Labor,omitempty"`
-	ForcedLabor      lsbool `json:"forced_labor,omitempty"`
-	ForcedChildLabor lsbool `json:"forced_child_labor,omitempty"`
-}
-
-func (api *CountryGoodsAPI) sendRequest() error {
-	api.endpoint = buildEndpoint(countryGoodsURI, api.Filters)
-
-	rawResponse, err := doRequest(api.endpoint.String(), api.SecretKey, api.Debug)
-	if err != nil {
-		return err
-	}
-
-	api.RawResponse = rawResponse
-
-	return nil
+	CountryProfileID int  `json:"country_profile_id,omitempty"`
+	GoodID           int  `json:"good_id,omitempty"`
+	ChildLabor       Bool `json:"child_Labor,omitempty"`
+	ForcedLabor      Bool `json:"forced_labor,omitempty"`
+	ForcedChildLabor Bool `json:"forced_child_labor,omitempty"`
 }
 
-func (api *CountryGoodsAPI) unmarshalData() ([]CountryGood, error) {
-	var countryGoods []CountryGood
-
-	err := json.Unmarshal(api.RawResponse, &countryGoods)
-	if err != nil {
-		return nil, err
-	}
-
-	return countryGoods, nil
+// MarshalJSON encodes the good's flags as the API sent them. A flag the
+// response did not include is left out rather than written as null.
+func (g CountryGood) MarshalJSON() ([]byte, error) {
+	return marshalOmitZero(g)
 }
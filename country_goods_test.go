@@ -8,10 +8,7 @@ func TestCountryGoodsUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := CountryGoodsAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := CountryGoodsEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -23,13 +20,13 @@ func TestCountryGoodsUnmarshalData(t *testing.T) {
 	if fRes.GoodID != 1 {
 		t.Error("Invalid GoodID: ", fRes.GoodID)
 	}
-	if fRes.ChildLabor != false {
+	if fRes.ChildLabor.State != BoolFalse {
 		t.Error("Invalid ChildLabor value: ", fRes.ChildLabor)
 	}
-	if fRes.ForcedLabor != false {
+	if fRes.ForcedLabor.State != BoolFalse {
 		t.Error("Invalid ForcedLabor value: ", fRes.ForcedLabor)
 	}
-	if fRes.ForcedChildLabor != false {
+	if fRes.ForcedChildLabor.State != BoolFalse {
 		t.Error("Invalid ForcedChildLabor value: ", fRes.ForcedChildLabor)
 	}
 }
@@ -1,11 +1,10 @@
 package laborstats
 
-import "encoding/json"
-
 // Request path for Country Profile data.
 const countryProfileURI = "childlabor_pro"
 
-type CountryProfileAPI LaborStatsAPI
+// CountryProfileEndpoint describes the Country Profile endpoint.
+var CountryProfileEndpoint = Endpoint[CountryProfile]{Path: countryProfileURI}
 
 type CountryProfile struct {
 	ID          int    `json:"id"`
@@ -14,27 +13,3 @@ type CountryProfile struct {
 	AdLevelID   int    `json:"advancement_id,omitempty"`
 	Description string `json:"description,omitempty"`
 }
-
-func (api *CountryProfileAPI) sendRequest() error {
-	api.endpoint = buildEndpoint(countryProfileURI, api.Filters)
-
-	rawResponse, err := doRequest(api.endpoint.String(), api.SecretKey, api.Debug)
-	if err != nil {
-		return err
-	}
-
-	api.RawResponse = rawResponse
-
-	return nil
-}
-
-func (api *CountryProfileAPI) unmarshalData() ([]CountryProfile, error) {
-	var countryProfiles []CountryProfile
-
-	err := json.Unmarshal(api.RawResponse, &countryProfiles)
-	if err != nil {
-		return nil, err
-	}
-
-	return countryProfiles, nil
-}
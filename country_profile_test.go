@@ -8,10 +8,7 @@ func TestCountryProfileUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := CountryProfileAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := CountryProfileEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
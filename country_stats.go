@@ -5,46 +5,55 @@ import "encoding/json"
 // Request path for Country Statistics data.
 const countryStatsURI = "childlabor_sta"
 
-type CountryStatsAPI LaborStatsAPI
+// CountryStatsEndpoint describes the Country Statistics endpoint.
+var CountryStatsEndpoint = Endpoint[CountryStat]{Path: countryStatsURI}
 
 type CountryStat struct {
-	CountryProfileID  int     `json:"country_profile_id"`
-	CWAgeRange        string  `json:"cws_age_range,omitempty"`
-	CWPercent         float64 `json:"cws_total_percentage_of_workin,omitempty"`
-	CWPopulation      int     `json:"cws_total_working_population"`
-	CWAgriculture     float64 `json:"cws_agriculture,omitempty"`
-	CWService         float64 `json:"cws_services,omitempty"`
-	CWIndustry        float64 `json:"cws_industry,omitempty"`
-	SchoolAttYear     string  `json:"esas_year,omitempty"`
-	SchoolAttAgeRange string  `json:"esas_age_range,omitempty"`
-	SchoolAttPercent  float64 `json:"esas_percentage,omitempty"`
-	CWASYear          string  `json:"cwas_year,omitempty"`
-	CWASAgeRange      string  `json:"cwas_age_range,omitempty"`
-	CWASTotal         float64 `json:"cwas_total,omitempty"`
-	PCRYear           string  `json:"upcr_year,omitempty"`
-	PCRRate           float64 `json:"upcr_rate,omitempty"`
+	CountryProfileID  int       `json:"country_profile_id"`
+	CWAgeRange        AgeRange  `json:"cws_age_range,omitempty"`
+	CWPercent         NullFloat `json:"cws_total_percentage_of_workin,omitempty"`
+	CWPopulation      NullInt   `json:"cws_total_working_population,omitempty"`
+	CWAgriculture     NullFloat `json:"cws_agriculture,omitempty"`
+	CWService         NullFloat `json:"cws_services,omitempty"`
+	CWIndustry        NullFloat `json:"cws_industry,omitempty"`
+	SchoolAttYear     YearRange `json:"esas_year,omitempty"`
+	SchoolAttAgeRange AgeRange  `json:"esas_age_range,omitempty"`
+	SchoolAttPercent  NullFloat `json:"esas_percentage,omitempty"`
+	CWASYear          YearRange `json:"cwas_year,omitempty"`
+	CWASAgeRange      AgeRange  `json:"cwas_age_range,omitempty"`
+	CWASTotal         NullFloat `json:"cwas_total,omitempty"`
+	PCRYear           YearRange `json:"upcr_year,omitempty"`
+	PCRRate           NullFloat `json:"upcr_rate,omitempty"`
 }
 
-func (api *CountryStatsAPI) sendRequest() error {
-	api.endpoint = buildEndpoint(countryStatsURI, api.Filters)
+// UnmarshalJSON decodes country statistics. A figure of zero whose survey
+// year is missing is treated as missing, as the API reports missing figures
+// that way. Non-zero figures are kept.
+func (s *CountryStat) UnmarshalJSON(b []byte) error {
+	type countryStat CountryStat
 
-	rawResponse, err := doRequest(api.endpoint.String(), api.SecretKey, api.Debug)
+	err := json.Unmarshal(b, (*countryStat)(s))
 	if err != nil {
 		return err
 	}
 
-	api.RawResponse = rawResponse
+	if s.SchoolAttYear.Missing() {
+		s.SchoolAttPercent = s.SchoolAttPercent.missingIfZero()
+	}
+	if s.CWASYear.Missing() {
+		s.CWASTotal = s.CWASTotal.missingIfZero()
+	}
+	if s.PCRYear.Missing() {
+		s.PCRRate = s.PCRRate.missingIfZero()
+	}
 
 	return nil
 }
 
-func (api *CountryStatsAPI) unmarshalData() ([]CountryStat, error) {
-	var countryStats []CountryStat
-
-	err := json.Unmarshal(api.RawResponse, &countryStats)
-	if err != nil {
-		return nil, err
-	}
+// MarshalJSON encodes country statistics as the API returned them. Keys
+// missing from the response are left out.
+func (s CountryStat) MarshalJSON() ([]byte, error) {
+	type countryStat CountryStat
 
-	return countryStats, nil
+	return marshalOmitZero(countryStat(s))
 }
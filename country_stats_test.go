@@ -1,6 +1,9 @@
 package laborstats
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestCountryStatsUnmarshalData(t *testing.T) {
 	dataMock, err := getDataMock("./testdata/country_stats.json")
@@ -8,10 +11,7 @@ func TestCountryStatsUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := CountryStatsAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := CountryStatsEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -24,46 +24,98 @@ func TestCountryStatsUnmarshalData(t *testing.T) {
 	if fRes.CountryProfileID != 1 {
 		t.Error("Invalid CountryProfileID value: ", fRes.CountryProfileID)
 	}
-	if fRes.CWAgeRange != "5-14" {
+	if fRes.CWAgeRange != (AgeRange{5, 14, `"5-14"`}) {
 		t.Error("Invalid CWAgeRange value: ", fRes.CWAgeRange)
 	}
-	if fRes.CWPercent != 7.5 {
+	if fRes.CWPercent != (NullFloat{7.5, true, "7.5"}) {
 		t.Error("Invalid CWPercent value: ", fRes.CWPercent)
 	}
-	if fRes.CWPopulation != 673949 {
+	if fRes.CWPopulation != (NullInt{673949, true, "673949"}) {
 		t.Error("Invalid CWPopulation value: ", fRes.CWPopulation)
 	}
-	if fRes.CWAgriculture != 0 {
+	if fRes.CWAgriculture != (NullFloat{0, true, "0"}) {
 		t.Error("Invalid CWAgriculture value: ", fRes.CWAgriculture)
 	}
-	if fRes.CWService != 0 {
+	if fRes.CWService != (NullFloat{0, true, "0"}) {
 		t.Error("Invalid CWService value: ", fRes.CWService)
 	}
-	if fRes.CWIndustry != 0 {
+	if fRes.CWIndustry != (NullFloat{0, true, "0"}) {
 		t.Error("Invalid CWIndustry value: ", fRes.CWIndustry)
 	}
-	if fRes.SchoolAttYear != "2010-11" {
+	if fRes.SchoolAttYear != (YearRange{2010, 2011, `"2010-11"`}) {
 		t.Error("Invalid ScoolAttYear value: ", fRes.SchoolAttYear)
 	}
-	if fRes.SchoolAttAgeRange != "5-14" {
+	if fRes.SchoolAttAgeRange != (AgeRange{5, 14, `"5-14"`}) {
 		t.Error("Invalid SchoolAttAgeRange value: ", fRes.SchoolAttAgeRange)
 	}
-	if fRes.SchoolAttPercent != 41.8 {
+	if fRes.SchoolAttPercent != (NullFloat{41.8, true, "41.8"}) {
 		t.Error("Invalid SchoolAttPercent value: ", fRes.SchoolAttPercent)
 	}
-	if fRes.CWASYear != "2010-11" {
+	if fRes.CWASYear != (YearRange{2010, 2011, `"2010-11"`}) {
 		t.Error("Invalid CWASYear value: ", fRes.CWASYear)
 	}
-	if fRes.CWASAgeRange != "7-14" {
+	if fRes.CWASAgeRange != (AgeRange{7, 14, `"7-14"`}) {
 		t.Error("Invalid CWASAgeRange value: ", fRes.CWASAgeRange)
 	}
-	if fRes.CWASTotal != 4.6 {
+	if fRes.CWASTotal != (NullFloat{4.6, true, "4.6"}) {
 		t.Error("Invalid CWASTotal value: ", fRes.CWASTotal)
 	}
-	if fRes.PCRYear != "0000" {
+	if !fRes.PCRYear.Missing() || fRes.PCRYear.Raw != `"0000"` {
 		t.Error("Invalid PCRYear value: ", fRes.PCRYear)
 	}
-	if fRes.PCRRate != 0 {
+	if fRes.PCRRate.Valid {
 		t.Error("Invalid PCRRate value: ", fRes.PCRRate)
 	}
 }
+
+func TestCountryStatMissingYear(t *testing.T) {
+	var s CountryStat
+	err := json.Unmarshal([]byte(`{
+		"esas_year": "2012",
+		"esas_percentage": 0,
+		"cwas_year": "0000",
+		"cwas_total": 3.2,
+		"upcr_year": "0000",
+		"upcr_rate": 0
+	}`), &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.SchoolAttPercent != (NullFloat{0, true, "0"}) {
+		t.Error("Invalid SchoolAttPercent value: ", s.SchoolAttPercent)
+	}
+	if s.CWASTotal != (NullFloat{3.2, true, "3.2"}) {
+		t.Error("Non-zero figure without survey year discarded: ", s.CWASTotal)
+	}
+	if s.PCRRate.Valid {
+		t.Error("Rate without survey year reported: ", s.PCRRate)
+	}
+}
+
+func TestCountryStatRoundTrip(t *testing.T) {
+	inputs := []string{
+		`{"country_profile_id":1}`,
+		`{"country_profile_id":1,"cws_total_percentage_of_workin":"12.5","cws_total_working_population":"1,234"}`,
+		`{"country_profile_id":1,"cws_agriculture":"N/A","cws_services":-1,"cws_industry":null}`,
+		`{"country_profile_id":1,"upcr_year":"0000","upcr_rate":0}`,
+		`{"country_profile_id":1,"upcr_year":"0000","upcr_rate":null}`,
+		`{"country_profile_id":1,"upcr_year":"0000","upcr_rate":3.2}`,
+	}
+
+	for _, in := range inputs {
+		var s CountryStat
+		if err := json.Unmarshal([]byte(in), &s); err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != in {
+			t.Errorf("Round trip of %s gave %s", in, b)
+		}
+	}
+}
@@ -8,10 +8,7 @@ func TestCountryUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := CountryAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := CountryEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,144 @@
+package laborstats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Number of endpoints FetchAll queries at once when FetchConcurrency is not
+// set.
+const defaultFetchConcurrency = 4
+
+// Dataset is a snapshot of every endpoint of the API.
+type Dataset struct {
+	// FetchedAt is the time the fetch started.
+	FetchedAt            time.Time
+	AdvancementLevels    []AdvancementLevel
+	Countries            []Country
+	CountryData          []CountryData
+	CountryGoods         []CountryGood
+	CountryProfiles      []CountryProfile
+	CountryStats         []CountryStat
+	Goods                []Good
+	Regions              []Region
+	Sectors              []Sector
+	SuggestedActionAreas []SuggestedActionArea
+	SuggestedActions     []SuggestedAction
+}
+
+// FetchError is returned by FetchAll when one or more endpoints could not be
+// fetched.
+type FetchError struct {
+	// Errors maps the request path of each failed endpoint to its error.
+	Errors map[string]error
+}
+
+func (e *FetchError) Error() string {
+	var paths []string
+	for path := range e.Errors {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var msgs []string
+	for _, path := range paths {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", path, e.Errors[path]))
+	}
+
+	return fmt.Sprintf("Failed to fetch %d endpoint(s). %s", len(paths), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the failed endpoints.
+func (e *FetchError) Unwrap() []error {
+	var errs []error
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// fetchTask fetches every page of one endpoint.
+type fetchTask struct {
+	path string
+	run  func(ctx context.Context) error
+}
+
+func fetchInto[T any](api *LaborStatsAPI, e Endpoint[T], dst *[]T) fetchTask {
+	return fetchTask{
+		path: e.Path,
+		run: func(ctx context.Context) error {
+			var err error
+			*dst, err = NewQuery(api, e).Pages(ctx).All()
+
+			return err
+		},
+	}
+}
+
+// FetchAll retrieves every page of every endpoint concurrently, querying at
+// most FetchConcurrency endpoints at once. Filters set on api are not
+// applied. If any endpoint fails, the data fetched from the others is
+// returned along with a *FetchError.
+func (api *LaborStatsAPI) FetchAll(ctx context.Context) (*Dataset, error) {
+	base := *api
+	base.Filters = nil
+	base.filter = nil
+
+	ds := &Dataset{FetchedAt: time.Now()}
+
+	tasks := []fetchTask{
+		fetchInto(&base, AdvancementLevelEndpoint, &ds.AdvancementLevels),
+		fetchInto(&base, CountryEndpoint, &ds.Countries),
+		fetchInto(&base, CountryDataEndpoint, &ds.CountryData),
+		fetchInto(&base, CountryGoodsEndpoint, &ds.CountryGoods),
+		fetchInto(&base, CountryProfileEndpoint, &ds.CountryProfiles),
+		fetchInto(&base, CountryStatsEndpoint, &ds.CountryStats),
+		fetchInto(&base, GoodEndpoint, &ds.Goods),
+		fetchInto(&base, RegionEndpoint, &ds.Regions),
+		fetchInto(&base, SectorEndpoint, &ds.Sectors),
+		fetchInto(&base, SuggestedActionAreaEndpoint, &ds.SuggestedActionAreas),
+		fetchInto(&base, SuggestedActionEndpoint, &ds.SuggestedActions),
+	}
+
+	concurrency := api.FetchConcurrency
+	if concurrency < 1 {
+		concurrency = defaultFetchConcurrency
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs = make(map[string]error)
+		sem  = make(chan struct{}, concurrency)
+	)
+
+	for _, task := range tasks {
+		wg.Add(1)
+
+		go func(task fetchTask) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := task.run(ctx); err != nil {
+				mu.Lock()
+				errs[task.path] = err
+				mu.Unlock()
+			}
+		}(task)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return ds, &FetchError{Errors: errs}
+	}
+
+	return ds, nil
+}
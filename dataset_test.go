@@ -0,0 +1,194 @@
+package laborstats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testDataFiles = map[string]string{
+	advancementLevelURI:    "advancement_level.json",
+	countryURI:             "country.json",
+	countryDataURI:         "country_data.json",
+	countryGoodsURI:        "country_goods.json",
+	countryProfileURI:      "country_profile.json",
+	countryStatsURI:        "country_stats.json",
+	goodURI:                "good.json",
+	regionURI:              "region.json",
+	sectorURI:              "sector.json",
+	suggestedActionAreaURI: "suggested_action_area.json",
+	suggestedActionURI:     "suggested_actions.json",
+}
+
+// newDatasetHandler serves the test data files by endpoint. Requests for
+// endpoints listed in failing return a server error. The returned function
+// reports the most requests seen in flight at once.
+func newDatasetHandler(t *testing.T, failing ...string) (http.Handler, func() int) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		maxSeen  int
+	)
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		time.Sleep(5 * time.Millisecond)
+
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+
+		for _, f := range failing {
+			if f == path {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		dataMock, err := getDataMock("./testdata/" + testDataFiles[path])
+		if err != nil {
+			t.Error(err)
+		}
+
+		w.Write(dataMock)
+	})
+
+	return h, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return maxSeen
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	h, maxInFlight := newDatasetHandler(t)
+	a, stop := newTestAPI(t, h)
+	defer stop()
+
+	a.FetchConcurrency = 2
+	a.AddFilter("limit", "1")
+
+	start := time.Now()
+
+	ds, err := a.FetchAll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ds.FetchedAt.Before(start) {
+		t.Error("Invalid FetchedAt: ", ds.FetchedAt)
+	}
+
+	if len(ds.AdvancementLevels) != 3 || len(ds.Countries) != 2 || len(ds.CountryData) != 2 ||
+		len(ds.CountryGoods) != 2 || len(ds.CountryProfiles) != 2 || len(ds.CountryStats) != 2 ||
+		len(ds.Goods) != 2 || len(ds.Regions) != 2 || len(ds.Sectors) != 2 ||
+		len(ds.SuggestedActionAreas) != 2 || len(ds.SuggestedActions) != 2 {
+		t.Errorf("Incomplete dataset: %+v", ds)
+	}
+
+	if maxInFlight() > 2 {
+		t.Error("Concurrency limit exceeded: ", maxInFlight())
+	}
+}
+
+func TestFetchAllPartialFailure(t *testing.T) {
+	h, _ := newDatasetHandler(t, goodURI, sectorURI)
+	a, stop := newTestAPI(t, h)
+	defer stop()
+
+	ds, err := a.FetchAll(context.Background())
+
+	var fe *FetchError
+	if !errors.As(err, &fe) {
+		t.Fatal("Expected FetchError, got: ", err)
+	}
+
+	if len(fe.Errors) != 2 || fe.Errors[goodURI] == nil || fe.Errors[sectorURI] == nil {
+		t.Error("Invalid endpoint errors: ", fe.Errors)
+	}
+
+	if !errors.Is(err, ErrInvalidResponse) {
+		t.Error("FetchError does not wrap endpoint errors: ", err)
+	}
+
+	if len(ds.Countries) != 2 {
+		t.Error("Data from successful endpoints not returned.")
+	}
+}
+
+// loadTestDataset decodes the test data files into a Dataset.
+func loadTestDataset(t *testing.T) *Dataset {
+	ds := &Dataset{FetchedAt: time.Now()}
+
+	load := func(path string, decode func([]byte) error) {
+		dataMock, err := getDataMock("./testdata/" + testDataFiles[path])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := decode(dataMock); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	load(advancementLevelURI, func(b []byte) (err error) {
+		ds.AdvancementLevels, err = AdvancementLevelEndpoint.decode(b)
+		return err
+	})
+	load(countryURI, func(b []byte) (err error) {
+		ds.Countries, err = CountryEndpoint.decode(b)
+		return err
+	})
+	load(countryDataURI, func(b []byte) (err error) {
+		ds.CountryData, err = CountryDataEndpoint.decode(b)
+		return err
+	})
+	load(countryGoodsURI, func(b []byte) (err error) {
+		ds.CountryGoods, err = CountryGoodsEndpoint.decode(b)
+		return err
+	})
+	load(countryProfileURI, func(b []byte) (err error) {
+		ds.CountryProfiles, err = CountryProfileEndpoint.decode(b)
+		return err
+	})
+	load(countryStatsURI, func(b []byte) (err error) {
+		ds.CountryStats, err = CountryStatsEndpoint.decode(b)
+		return err
+	})
+	load(goodURI, func(b []byte) (err error) {
+		ds.Goods, err = GoodEndpoint.decode(b)
+		return err
+	})
+	load(regionURI, func(b []byte) (err error) {
+		ds.Regions, err = RegionEndpoint.decode(b)
+		return err
+	})
+	load(sectorURI, func(b []byte) (err error) {
+		ds.Sectors, err = SectorEndpoint.decode(b)
+		return err
+	})
+	load(suggestedActionAreaURI, func(b []byte) (err error) {
+		ds.SuggestedActionAreas, err = SuggestedActionAreaEndpoint.decode(b)
+		return err
+	})
+	load(suggestedActionURI, func(b []byte) (err error) {
+		ds.SuggestedActions, err = SuggestedActionEndpoint.decode(b)
+		return err
+	})
+
+	return ds
+}
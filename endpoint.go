@@ -0,0 +1,92 @@
+package laborstats
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Endpoint describes an API endpoint whose results decode to values of type
+// T. Adding support for an endpoint only requires declaring its Endpoint:
+//
+//	var RegionEndpoint = Endpoint[Region]{Path: "childlabor_reg"}
+//
+// The JSON field names of T are the fields that may be used in a Filter.
+type Endpoint[T any] struct {
+	// Path is the request path of the endpoint, relative to the base URL.
+	Path string
+}
+
+// fields returns the JSON field names of the endpoint's result type.
+func (e Endpoint[T]) fields() []string {
+	var model T
+
+	return modelFields(model)
+}
+
+// decode unmarshals a raw API response.
+func (e Endpoint[T]) decode(b []byte) ([]T, error) {
+	var results []T
+
+	err := json.Unmarshal(b, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Query runs requests against an endpoint using the settings of an API
+// instance, including its filters, retry policy and rate limiter.
+type Query[T any] struct {
+	api      *LaborStatsAPI
+	endpoint Endpoint[T]
+}
+
+// NewQuery returns a query against endpoint e which uses the settings of
+// api.
+func NewQuery[T any](api *LaborStatsAPI, e Endpoint[T]) *Query[T] {
+	return &Query[T]{
+		api:      api,
+		endpoint: e,
+	}
+}
+
+// Filter returns a copy of the query which applies f in place of any Filter
+// already set.
+func (q *Query[T]) Filter(f *Filter) *Query[T] {
+	return NewQuery(q.api.WithFilter(f), q.endpoint)
+}
+
+// Do submits the query and returns its results.
+func (q *Query[T]) Do(ctx context.Context) ([]T, error) {
+	cfg, err := q.api.requestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := buildEndpoint(cfg.BaseURL, q.endpoint.Path, q.endpoint.fields(), cfg.filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, err := cfg.doRequest(ctx, q.endpoint.Path, endpoint.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Strict {
+		err = checkSchema(q.endpoint.Path, q.endpoint.fields(), rawResponse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return q.endpoint.decode(rawResponse)
+}
+
+// Pages returns a pager over the query's results.
+func (q *Query[T]) Pages(ctx context.Context) *Pager[T] {
+	return newPager(ctx, q.api, func(api *LaborStatsAPI, ctx context.Context) ([]T, error) {
+		return NewQuery(api, q.endpoint).Do(ctx)
+	})
+}
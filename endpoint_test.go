@@ -0,0 +1,56 @@
+package laborstats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestQueryDo(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/sector.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(dataMock)
+	}))
+	defer stop()
+
+	custom := Endpoint[Sector]{Path: "custom_sec"}
+
+	result, err := NewQuery(a, custom).Filter(NewFilter().Where("name", "Mining")).Do(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 2 || result[1].Name != "Mining" {
+		t.Error("Invalid results: ", result)
+	}
+
+	expected := "/custom_sec/filter_object/" + `{"field":"name","operator":"eq","value":"Mining"}`
+	if len(paths) != 1 || paths[0] != expected {
+		t.Error("Invalid request path: ", paths)
+	}
+}
+
+func TestQueryValidatesFields(t *testing.T) {
+	a := NewLaborStatsAPI(testAPIKey)
+
+	_, err := NewQuery(a, SectorEndpoint).Filter(NewFilter().OrderBy("region_id", Ascending)).Do(context.Background())
+	if !errors.Is(err, ErrInvalidFilter) {
+		t.Error("Expected ErrInvalidFilter, got: ", err)
+	}
+}
+
+func TestEndpointFields(t *testing.T) {
+	fields := GoodEndpoint.fields()
+
+	if len(fields) != 3 || fields[0] != "id" || fields[1] != "name" || fields[2] != "sector_id" {
+		t.Error("Invalid fields: ", fields)
+	}
+}
@@ -0,0 +1,98 @@
+package laborstats
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	// LaborStatsAPIError matches errors whose message was returned by the
+	// API.
+	LaborStatsAPIError = errors.New("The API request returned an error.")
+	// ErrInvalidFilter matches errors caused by an invalid filter.
+	ErrInvalidFilter = errors.New("Invalid query parameter provided.")
+	// ErrInvalidResponse matches errors caused by an unexpected HTTP status
+	// code.
+	ErrInvalidResponse = errors.New("The HTTP request failed.")
+
+	// ErrUnauthorized matches errors caused by a missing or invalid API key.
+	ErrUnauthorized = errors.New("The API key was rejected.")
+	// ErrRateLimited matches errors caused by exceeding a rate limit, whether
+	// enforced by the API or by a client-side RateLimiter.
+	ErrRateLimited = errors.New("The request rate limit was exceeded.")
+	// ErrNotFound matches errors caused by a request for an unknown resource.
+	ErrNotFound = errors.New("The requested resource was not found.")
+	// ErrSchemaMismatch matches errors caused by a response whose keys do
+	// not match the fields of the endpoint's result type.
+	ErrSchemaMismatch = errors.New("The API response did not match the expected schema.")
+)
+
+// APIError holds error information returned from an API request. It
+// matches LaborStatsAPIError when the API supplied an error message and
+// ErrInvalidResponse otherwise, as well as ErrUnauthorized, ErrRateLimited
+// and ErrNotFound where they apply:
+//
+//	if errors.Is(err, laborstats.ErrUnauthorized) {
+//		...
+//	}
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message returned by the API, if any.
+	Message string
+	// Endpoint is the request path of the endpoint queried.
+	Endpoint string
+	// RequestURL is the full URL of the failed request.
+	RequestURL string
+	// ContentType is the Content-Type header of the response.
+	ContentType string
+	// Body holds the start of the response body when it did not contain an
+	// API error message.
+	Body string
+	// RetryAfter is the delay requested by the API's Retry-After header.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s HTTP status code returned was: %d.", ErrInvalidResponse, e.StatusCode)
+	if e.StatusCode == http.StatusOK {
+		msg = fmt.Sprintf("%s Unexpected response Content-Type: %q.", ErrInvalidResponse, e.ContentType)
+	}
+
+	if e.Body != "" {
+		msg = fmt.Sprintf("%s Response body: %s", msg, e.Body)
+	}
+
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s The error message was: %s", LaborStatsAPIError, strings.TrimSpace(e.Message))
+	}
+
+	if e.Endpoint != "" {
+		msg = fmt.Sprintf("%s (endpoint: %s)", msg, e.Endpoint)
+	}
+
+	return msg
+}
+
+// Is reports whether the error matches target.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case LaborStatsAPIError:
+		return e.Message != ""
+	case ErrInvalidResponse:
+		return e.Message == ""
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized ||
+			e.StatusCode == http.StatusForbidden ||
+			strings.Contains(strings.ToLower(e.Message), "api key")
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package laborstats
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	cases := []struct {
+		err    *APIError
+		target error
+		match  bool
+	}{
+		{&APIError{StatusCode: 401}, ErrUnauthorized, true},
+		{&APIError{StatusCode: 403}, ErrUnauthorized, true},
+		{&APIError{StatusCode: 200, Message: "Invalid API Key "}, ErrUnauthorized, true},
+		{&APIError{StatusCode: 429}, ErrRateLimited, true},
+		{&APIError{StatusCode: 404}, ErrNotFound, true},
+		{&APIError{StatusCode: 500}, ErrInvalidResponse, true},
+		{&APIError{StatusCode: 500}, LaborStatsAPIError, false},
+		{&APIError{StatusCode: 500, Message: "Server error"}, LaborStatsAPIError, true},
+		{&APIError{StatusCode: 404}, ErrUnauthorized, false},
+		{&APIError{StatusCode: 500}, ErrNotFound, false},
+	}
+
+	for _, c := range cases {
+		if errors.Is(c.err, c.target) != c.match {
+			t.Errorf("errors.Is(%v, %v) != %v", c.err, c.target, c.match)
+		}
+	}
+
+	if !errors.Is(&RateLimitError{}, ErrRateLimited) {
+		t.Error("RateLimitError does not match ErrRateLimited.")
+	}
+}
+
+func TestQueryReturnsAPIError(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/error.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+sectorURI {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write(dataMock)
+	}))
+	defer stop()
+
+	_, err = a.QueryCountry()
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatal("Expected APIError, got: ", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnauthorized {
+		t.Error("Invalid StatusCode: ", apiErr.StatusCode)
+	}
+	if apiErr.Message != "Invalid API Key " {
+		t.Error("Invalid Message: ", apiErr.Message)
+	}
+	if apiErr.Endpoint != countryURI {
+		t.Error("Invalid Endpoint: ", apiErr.Endpoint)
+	}
+	if apiErr.RequestURL != a.BaseURL.String()+"/"+countryURI {
+		t.Error("Invalid RequestURL: ", apiErr.RequestURL)
+	}
+	if !errors.Is(err, ErrUnauthorized) || !errors.Is(err, LaborStatsAPIError) {
+		t.Error("APIError does not match expected targets: ", err)
+	}
+
+	_, err = a.QuerySector()
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("Expected ErrNotFound, got: ", err)
+	}
+}
@@ -0,0 +1,374 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layout used for start_date and end_date filter values.
+const filterDateLayout = "2006-01-02"
+
+// SortOrder is the direction in which ordered results are returned.
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// Filter is a typed set of query parameters applied to an API request.
+// Filters are built by chaining methods:
+//
+//	f := NewFilter().Limit(10).OrderBy("name", Ascending)
+//
+// Invalid values are reported when the filter is used in a query.
+type Filter struct {
+	limit      int
+	offset     int
+	orderBy    string
+	order      SortOrder
+	dateColumn string
+	start      time.Time
+	end        time.Time
+	where      map[string]string
+	raw        QueryFilters
+	err        error
+}
+
+// NewFilter returns an empty filter.
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+// Limit sets the maximum number of results returned.
+func (f *Filter) Limit(n int) *Filter {
+	if n < 1 {
+		f.setErr(fmt.Errorf("%w Limit must be positive, got %d.", ErrInvalidFilter, n))
+	}
+
+	f.limit = n
+
+	return f
+}
+
+// Offset sets the number of results skipped before the first result returned.
+func (f *Filter) Offset(n int) *Filter {
+	if n < 0 {
+		f.setErr(fmt.Errorf("%w Offset must not be negative, got %d.", ErrInvalidFilter, n))
+	}
+
+	f.offset = n
+
+	return f
+}
+
+// OrderBy orders results by field in the given direction.
+func (f *Filter) OrderBy(field string, order SortOrder) *Filter {
+	if order != Ascending && order != Descending {
+		f.setErr(fmt.Errorf("%w Unknown sort order: %s.", ErrInvalidFilter, order))
+	}
+
+	f.orderBy = field
+	f.order = order
+
+	return f
+}
+
+// DateColumn sets the field that Between compares against.
+func (f *Filter) DateColumn(field string) *Filter {
+	f.dateColumn = field
+
+	return f
+}
+
+// Between restricts results to those whose date column falls between start
+// and end, inclusive.
+func (f *Filter) Between(start, end time.Time) *Filter {
+	if end.Before(start) {
+		f.setErr(fmt.Errorf("%w End date %s is before start date %s.", ErrInvalidFilter,
+			end.Format(filterDateLayout), start.Format(filterDateLayout)))
+	}
+
+	f.start = start
+	f.end = end
+
+	return f
+}
+
+// Where restricts results to those whose field equals value.
+func (f *Filter) Where(field string, value interface{}) *Filter {
+	if f.where == nil {
+		f.where = make(map[string]string)
+	}
+
+	f.where[field] = fmt.Sprint(value)
+
+	return f
+}
+
+func (f *Filter) setErr(err error) {
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+// clone returns a deep copy of f. A nil filter clones to an empty filter.
+func (f *Filter) clone() *Filter {
+	c := &Filter{}
+	if f == nil {
+		return c
+	}
+
+	*c = *f
+	c.where = nil
+	c.raw = nil
+
+	for field, val := range f.where {
+		c.Where(field, val)
+	}
+
+	if f.raw != nil {
+		c.raw = f.raw.clone()
+	}
+
+	return c
+}
+
+// filterFromMap converts legacy filters to a Filter. The values are rendered
+// verbatim.
+func filterFromMap(filterMap QueryFilters) (*Filter, error) {
+	f := NewFilter()
+
+	for key := range filterMap {
+		if !filterIsValid(key) {
+			return nil, fmt.Errorf("%w Unknown filter: %s.", ErrInvalidFilter, key)
+		}
+	}
+
+	if len(filterMap) > 0 {
+		f.raw = filterMap.clone()
+	}
+
+	return f, nil
+}
+
+// merge returns a copy of f with any parameters set on o applied on top.
+func (f *Filter) merge(o *Filter) *Filter {
+	m := f.clone()
+	if o == nil {
+		return m
+	}
+
+	if o.err != nil {
+		m.setErr(o.err)
+	}
+	if o.limit != 0 {
+		m.limit = o.limit
+	}
+	if o.offset != 0 {
+		m.offset = o.offset
+	}
+	if o.orderBy != "" {
+		m.orderBy, m.order = o.orderBy, o.order
+	}
+	if o.dateColumn != "" {
+		m.dateColumn = o.dateColumn
+	}
+	if !o.start.IsZero() || !o.end.IsZero() {
+		m.start, m.end = o.start, o.end
+	}
+	for field, val := range o.where {
+		m.Where(field, val)
+	}
+	for key, val := range o.raw {
+		if m.raw == nil {
+			m.raw = make(QueryFilters)
+		}
+		m.raw[key] = val
+	}
+
+	return m
+}
+
+// validate checks the filter against fields, the fields available on the
+// endpoint at path. Field names are not checked if fields is nil.
+func (f *Filter) validate(path string, fields []string) error {
+	if f == nil {
+		return nil
+	}
+
+	if f.err != nil {
+		return f.err
+	}
+
+	hasRange := !f.start.IsZero() || !f.end.IsZero()
+	if hasRange && f.dateColumn == "" {
+		return fmt.Errorf("%w Between requires a date column.", ErrInvalidFilter)
+	}
+
+	if fields == nil {
+		return nil
+	}
+
+	check := func(field string) error {
+		if field != "" && !containsString(fields, field) {
+			return fmt.Errorf("%w Unknown field for %s: %s.", ErrInvalidFilter, path, field)
+		}
+
+		return nil
+	}
+
+	if err := check(f.orderBy); err != nil {
+		return err
+	}
+	if err := check(f.dateColumn); err != nil {
+		return err
+	}
+	for field := range f.where {
+		if err := check(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pageBounds returns the limit and offset set on the filter. Typed values
+// take precedence over legacy values set for the same name, which must be
+// valid numbers.
+func (f *Filter) pageBounds() (limit, offset int, err error) {
+	if f == nil {
+		return 0, 0, nil
+	}
+
+	limit, offset = f.limit, f.offset
+
+	if raw, ok := f.raw["limit"]; ok && limit == 0 {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return 0, 0, fmt.Errorf("%w Limit must be positive, got %q.", ErrInvalidFilter, raw)
+		}
+	}
+
+	if raw, ok := f.raw["offset"]; ok && offset == 0 {
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("%w Offset must not be negative, got %q.", ErrInvalidFilter, raw)
+		}
+	}
+
+	return limit, offset, nil
+}
+
+// params returns the filter parameters keyed by filter name. Typed values
+// take precedence over legacy values set for the same name.
+func (f *Filter) params() map[string]string {
+	p := make(map[string]string)
+	if f == nil {
+		return p
+	}
+
+	for key, val := range f.raw {
+		p[key] = val
+	}
+
+	if f.limit > 0 {
+		p["limit"] = strconv.Itoa(f.limit)
+	}
+	if f.offset > 0 {
+		p["offset"] = strconv.Itoa(f.offset)
+	}
+	if f.orderBy != "" {
+		p["sort_by"] = f.orderBy
+		p["sort"] = string(f.order)
+	}
+	if f.dateColumn != "" {
+		p["date_column"] = f.dateColumn
+	}
+	if !f.start.IsZero() {
+		p["start_date"] = f.start.Format(filterDateLayout)
+	}
+	if !f.end.IsZero() {
+		p["end_date"] = f.end.Format(filterDateLayout)
+	}
+	if len(f.where) > 0 {
+		p["filter_object"] = f.filterObject()
+	}
+
+	return p
+}
+
+type filterCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// filterObject renders the equality conditions as a DOL filter object.
+// Conditions are sorted by field so the output is stable.
+func (f *Filter) filterObject() string {
+	var fields []string
+	for field := range f.where {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var conds []filterCondition
+	for _, field := range fields {
+		conds = append(conds, filterCondition{field, "eq", f.where[field]})
+	}
+
+	var obj interface{} = conds[0]
+	if len(conds) > 1 {
+		obj = map[string][]filterCondition{"and": conds}
+	}
+
+	// Marshaling plain structs and strings cannot fail.
+	b, _ := json.Marshal(obj)
+
+	return string(b)
+}
+
+// segments renders the filter as request path segments in canonical order.
+func (f *Filter) segments() []string {
+	var segs []string
+
+	p := f.params()
+	for _, key := range validFilterKeys {
+		if val, ok := p[key]; ok {
+			segs = append(segs, key, val)
+		}
+	}
+
+	return segs
+}
+
+// modelFields returns the JSON field names of a model struct.
+func modelFields(model interface{}) []string {
+	var fields []string
+
+	t := reflect.TypeOf(model)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
+
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
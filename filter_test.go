@@ -0,0 +1,83 @@
+package laborstats
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFilterSegmentsCanonical(t *testing.T) {
+	start := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2014, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	f := NewFilter().
+		Where("region_id", 2).
+		Where("iso2", "BD").
+		DateColumn("profile_year").
+		Between(start, end).
+		OrderBy("name", Descending).
+		Offset(20).
+		Limit(10)
+
+	endpoint, err := buildEndpoint(nil, "myPath", nil, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "https://data.dol.gov/get/myPath/limit/10/offset/20/sort_by/name/sort/desc" +
+		"/date_column/profile_year/start_date/2014-01-01/end_date/2014-12-31" +
+		"/filter_object/%7B%22and%22:%5B%7B%22field%22:%22iso2%22%2C%22operator%22:%22eq%22%2C%22value%22:%22BD%22%7D%2C" +
+		"%7B%22field%22:%22region_id%22%2C%22operator%22:%22eq%22%2C%22value%22:%222%22%7D%5D%7D"
+
+	for i := 0; i < 10; i++ {
+		if endpoint.String() != expected {
+			t.Fatal("Invalid endpoint built: ", endpoint.String())
+		}
+
+		endpoint, _ = buildEndpoint(nil, "myPath", nil, f)
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	start := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	invalid := map[string]*Filter{
+		"zero limit":       NewFilter().Limit(0),
+		"negative offset":  NewFilter().Offset(-1),
+		"bad sort order":   NewFilter().OrderBy("name", "up"),
+		"unknown order by": NewFilter().OrderBy("population", Ascending),
+		"unknown where":    NewFilter().Where("population", 1),
+		"no date column":   NewFilter().Between(start, start),
+		"reversed range":   NewFilter().DateColumn("name").Between(start, start.AddDate(0, 0, -1)),
+	}
+
+	for name, f := range invalid {
+		if err := f.validate(countryURI, CountryEndpoint.fields()); err == nil {
+			t.Error("Invalid filter accepted: ", name)
+		}
+	}
+
+	valid := NewFilter().Limit(5).OrderBy("iso3", Ascending).Where("region_id", 1)
+	if err := valid.validate(countryURI, CountryEndpoint.fields()); err != nil {
+		t.Error("Valid filter rejected: ", err)
+	}
+}
+
+func TestFilterMerge(t *testing.T) {
+	legacy, err := filterFromMap(QueryFilters{"limit": "10", "order": "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := legacy.merge(NewFilter().Limit(5))
+
+	p := m.params()
+	if p["limit"] != "5" {
+		t.Error("Typed limit did not take precedence: ", p["limit"])
+	}
+	if p["order"] != "name" {
+		t.Error("Legacy filter dropped: ", p["order"])
+	}
+	if legacy.limit != 0 {
+		t.Error("Merge modified the receiver.")
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/gmccue/go-ilab-childlabor
+
+go 1.20
@@ -8,10 +8,7 @@ func TestGoodUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := GoodAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := GoodEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,336 @@
+package laborstats
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Index resolves the foreign keys between the records of a Dataset, so that
+// related records can be navigated directly:
+//
+//	idx := laborstats.NewIndex(ds)
+//	for _, p := range idx.Country(12).Profiles() {
+//		log.Println(p.ProfileYear, p.AdvancementLevel().Name)
+//	}
+//
+// An Index is read-only and safe for concurrent use.
+type Index struct {
+	regions     map[int]*Region
+	sectors     map[int]*Sector
+	levels      map[int]*AdvancementLevel
+	actionAreas map[int]*SuggestedActionArea
+	countries   map[int]*CountryNode
+	profiles    map[int]*CountryProfileNode
+	goods       map[int]*GoodNode
+
+	countryList []*CountryNode
+	goodList    []*GoodNode
+
+	countriesByRegion  map[int][]*CountryNode
+	goodsBySector      map[int][]*GoodNode
+	profilesByCountry  map[int][]*CountryProfileNode
+	countryGoods       map[int][]*CountryGoodNode
+	countryGoodsByGood map[int][]*CountryGoodNode
+	stats              map[int][]CountryStat
+	data               map[int][]CountryData
+	actions            map[int][]*SuggestedActionNode
+
+	byISO2 map[string]*CountryNode
+	byISO3 map[string]*CountryNode
+	byName map[string]*CountryNode
+
+	goodsByName map[string]*GoodNode
+
+	dangling []DanglingRef
+}
+
+// CountryNode is a Country with its relations resolved.
+type CountryNode struct {
+	Country
+	idx *Index
+}
+
+// CountryProfileNode is a CountryProfile with its relations resolved.
+type CountryProfileNode struct {
+	CountryProfile
+	idx *Index
+}
+
+// GoodNode is a Good with its relations resolved.
+type GoodNode struct {
+	Good
+	idx *Index
+}
+
+// CountryGoodNode is a CountryGood with its relations resolved.
+type CountryGoodNode struct {
+	CountryGood
+	idx *Index
+}
+
+// SuggestedActionNode is a SuggestedAction with its relations resolved.
+type SuggestedActionNode struct {
+	SuggestedAction
+	idx *Index
+}
+
+// DanglingRef describes a foreign key which refers to a record missing from
+// the dataset.
+type DanglingRef struct {
+	// Model is the type name of the referring record, e.g. "CountryGood".
+	Model string
+	// Position is the position of the referring record in its Dataset slice.
+	Position int
+	// Field is the JSON name of the foreign key field.
+	Field string
+	// Value is the missing ID.
+	Value int
+}
+
+func (d DanglingRef) String() string {
+	return fmt.Sprintf("%s[%d].%s refers to missing record %d", d.Model, d.Position, d.Field, d.Value)
+}
+
+// NewIndex builds an index over ds. The dataset should not be modified
+// while the index is in use.
+func NewIndex(ds *Dataset) *Index {
+	idx := &Index{
+		regions:            make(map[int]*Region),
+		sectors:            make(map[int]*Sector),
+		levels:             make(map[int]*AdvancementLevel),
+		actionAreas:        make(map[int]*SuggestedActionArea),
+		countries:          make(map[int]*CountryNode),
+		profiles:           make(map[int]*CountryProfileNode),
+		goods:              make(map[int]*GoodNode),
+		countriesByRegion:  make(map[int][]*CountryNode),
+		goodsBySector:      make(map[int][]*GoodNode),
+		profilesByCountry:  make(map[int][]*CountryProfileNode),
+		countryGoods:       make(map[int][]*CountryGoodNode),
+		countryGoodsByGood: make(map[int][]*CountryGoodNode),
+		stats:              make(map[int][]CountryStat),
+		data:               make(map[int][]CountryData),
+		actions:            make(map[int][]*SuggestedActionNode),
+	}
+
+	for i := range ds.Regions {
+		idx.regions[ds.Regions[i].ID] = &ds.Regions[i]
+	}
+	for i := range ds.Sectors {
+		idx.sectors[ds.Sectors[i].ID] = &ds.Sectors[i]
+	}
+	for i := range ds.AdvancementLevels {
+		idx.levels[ds.AdvancementLevels[i].ID] = &ds.AdvancementLevels[i]
+	}
+	for i := range ds.SuggestedActionAreas {
+		idx.actionAreas[ds.SuggestedActionAreas[i].ID] = &ds.SuggestedActionAreas[i]
+	}
+	for _, c := range ds.Countries {
+		n := &CountryNode{Country: c, idx: idx}
+		idx.countries[c.ID] = n
+		idx.countryList = append(idx.countryList, n)
+	}
+	for _, p := range ds.CountryProfiles {
+		idx.profiles[p.ID] = &CountryProfileNode{CountryProfile: p, idx: idx}
+	}
+	for _, g := range ds.Goods {
+		n := &GoodNode{Good: g, idx: idx}
+		idx.goods[g.ID] = n
+		idx.goodList = append(idx.goodList, n)
+	}
+
+	for i, c := range idx.countryList {
+		if c.RegionID != 0 {
+			idx.checkRef("Country", i, "region_id", c.RegionID, idx.regions[c.RegionID] != nil)
+			idx.countriesByRegion[c.RegionID] = append(idx.countriesByRegion[c.RegionID], c)
+		}
+	}
+
+	for i, p := range ds.CountryProfiles {
+		n := idx.profiles[p.ID]
+		idx.checkRef("CountryProfile", i, "country_id", p.CountryID, idx.countries[p.CountryID] != nil)
+		if p.AdLevelID != 0 {
+			idx.checkRef("CountryProfile", i, "advancement_id", p.AdLevelID, idx.levels[p.AdLevelID] != nil)
+		}
+		idx.profilesByCountry[p.CountryID] = append(idx.profilesByCountry[p.CountryID], n)
+	}
+
+	for i, g := range idx.goodList {
+		if g.SectorID != 0 {
+			idx.checkRef("Good", i, "sector_id", g.SectorID, idx.sectors[g.SectorID] != nil)
+			idx.goodsBySector[g.SectorID] = append(idx.goodsBySector[g.SectorID], g)
+		}
+	}
+
+	for i, cg := range ds.CountryGoods {
+		n := &CountryGoodNode{CountryGood: cg, idx: idx}
+		idx.checkRef("CountryGood", i, "country_profile_id", cg.CountryProfileID, idx.profiles[cg.CountryProfileID] != nil)
+		idx.checkRef("CountryGood", i, "good_id", cg.GoodID, idx.goods[cg.GoodID] != nil)
+		idx.countryGoods[cg.CountryProfileID] = append(idx.countryGoods[cg.CountryProfileID], n)
+		idx.countryGoodsByGood[cg.GoodID] = append(idx.countryGoodsByGood[cg.GoodID], n)
+	}
+
+	for i, s := range ds.CountryStats {
+		idx.checkRef("CountryStat", i, "country_profile_id", s.CountryProfileID, idx.profiles[s.CountryProfileID] != nil)
+		idx.stats[s.CountryProfileID] = append(idx.stats[s.CountryProfileID], s)
+	}
+
+	for i, d := range ds.CountryData {
+		idx.checkRef("CountryData", i, "country_profile_id", d.CountryProfileID, idx.profiles[d.CountryProfileID] != nil)
+		idx.data[d.CountryProfileID] = append(idx.data[d.CountryProfileID], d)
+	}
+
+	for i, a := range ds.SuggestedActions {
+		n := &SuggestedActionNode{SuggestedAction: a, idx: idx}
+		idx.checkRef("SuggestedAction", i, "country_profile_id", a.CountryProfileID, idx.profiles[a.CountryProfileID] != nil)
+		idx.checkRef("SuggestedAction", i, "area_id", a.ActionAreaID, idx.actionAreas[a.ActionAreaID] != nil)
+		idx.actions[a.CountryProfileID] = append(idx.actions[a.CountryProfileID], n)
+	}
+
+	idx.buildLookup()
+
+	for _, profiles := range idx.profilesByCountry {
+		sort.SliceStable(profiles, func(i, j int) bool {
+			return profiles[i].ProfileYear < profiles[j].ProfileYear
+		})
+	}
+
+	return idx
+}
+
+func (idx *Index) checkRef(model string, pos int, field string, value int, ok bool) {
+	if !ok {
+		idx.dangling = append(idx.dangling, DanglingRef{model, pos, field, value})
+	}
+}
+
+// Dangling returns the foreign keys which refer to records missing from the
+// dataset.
+func (idx *Index) Dangling() []DanglingRef {
+	return idx.dangling
+}
+
+// Countries returns every country in dataset order.
+func (idx *Index) Countries() []*CountryNode {
+	return idx.countryList
+}
+
+// Goods returns every good in dataset order.
+func (idx *Index) Goods() []*GoodNode {
+	return idx.goodList
+}
+
+// Country returns the country with the given ID, or nil.
+func (idx *Index) Country(id int) *CountryNode {
+	return idx.countries[id]
+}
+
+// CountryProfile returns the country profile with the given ID, or nil.
+func (idx *Index) CountryProfile(id int) *CountryProfileNode {
+	return idx.profiles[id]
+}
+
+// Good returns the good with the given ID, or nil.
+func (idx *Index) Good(id int) *GoodNode {
+	return idx.goods[id]
+}
+
+// Region returns the region with the given ID, or nil.
+func (idx *Index) Region(id int) *Region {
+	return idx.regions[id]
+}
+
+// Sector returns the sector with the given ID, or nil.
+func (idx *Index) Sector(id int) *Sector {
+	return idx.sectors[id]
+}
+
+// AdvancementLevel returns the advancement level with the given ID, or nil.
+func (idx *Index) AdvancementLevel(id int) *AdvancementLevel {
+	return idx.levels[id]
+}
+
+// SuggestedActionArea returns the suggested action area with the given ID,
+// or nil.
+func (idx *Index) SuggestedActionArea(id int) *SuggestedActionArea {
+	return idx.actionAreas[id]
+}
+
+// CountriesInRegion returns the countries in the region with the given ID.
+func (idx *Index) CountriesInRegion(regionID int) []*CountryNode {
+	return idx.countriesByRegion[regionID]
+}
+
+// GoodsInSector returns the goods in the sector with the given ID.
+func (idx *Index) GoodsInSector(sectorID int) []*GoodNode {
+	return idx.goodsBySector[sectorID]
+}
+
+// Region returns the country's region, or nil.
+func (c *CountryNode) Region() *Region {
+	return c.idx.regions[c.RegionID]
+}
+
+// Profiles returns the country's profiles ordered by year.
+func (c *CountryNode) Profiles() []*CountryProfileNode {
+	return c.idx.profilesByCountry[c.ID]
+}
+
+// Country returns the profiled country, or nil.
+func (p *CountryProfileNode) Country() *CountryNode {
+	return p.idx.countries[p.CountryID]
+}
+
+// AdvancementLevel returns the profile's advancement level, or nil.
+func (p *CountryProfileNode) AdvancementLevel() *AdvancementLevel {
+	return p.idx.levels[p.AdLevelID]
+}
+
+// Goods returns the goods listed on the profile.
+func (p *CountryProfileNode) Goods() []*CountryGoodNode {
+	return p.idx.countryGoods[p.ID]
+}
+
+// Stats returns the profile's statistics.
+func (p *CountryProfileNode) Stats() []CountryStat {
+	return p.idx.stats[p.ID]
+}
+
+// Data returns the profile's legal framework data.
+func (p *CountryProfileNode) Data() []CountryData {
+	return p.idx.data[p.ID]
+}
+
+// SuggestedActions returns the actions suggested for the profile.
+func (p *CountryProfileNode) SuggestedActions() []*SuggestedActionNode {
+	return p.idx.actions[p.ID]
+}
+
+// Sector returns the good's sector, or nil.
+func (g *GoodNode) Sector() *Sector {
+	return g.idx.sectors[g.SectorID]
+}
+
+// CountryGoods returns the country profile listings of the good.
+func (g *GoodNode) CountryGoods() []*CountryGoodNode {
+	return g.idx.countryGoodsByGood[g.ID]
+}
+
+// Profile returns the country profile listing the good, or nil.
+func (cg *CountryGoodNode) Profile() *CountryProfileNode {
+	return cg.idx.profiles[cg.CountryProfileID]
+}
+
+// Good returns the listed good, or nil.
+func (cg *CountryGoodNode) Good() *GoodNode {
+	return cg.idx.goods[cg.GoodID]
+}
+
+// Profile returns the country profile the action is suggested for, or nil.
+func (a *SuggestedActionNode) Profile() *CountryProfileNode {
+	return a.idx.profiles[a.CountryProfileID]
+}
+
+// Area returns the action's area, or nil.
+func (a *SuggestedActionNode) Area() *SuggestedActionArea {
+	return a.idx.actionAreas[a.ActionAreaID]
+}
@@ -0,0 +1,86 @@
+package laborstats
+
+import "testing"
+
+func TestIndexResolve(t *testing.T) {
+	idx := NewIndex(loadTestDataset(t))
+
+	if d := idx.Dangling(); len(d) != 0 {
+		t.Error("Unexpected dangling references: ", d)
+	}
+
+	c := idx.Country(1)
+	if c == nil {
+		t.Fatal("Country 1 not found.")
+	}
+
+	if c.Region() == nil || c.Region().Name != "Asia & Pacific" {
+		t.Error("Invalid Region: ", c.Region())
+	}
+
+	profiles := c.Profiles()
+	if len(profiles) != 1 {
+		t.Fatal("Invalid profile count: ", len(profiles))
+	}
+
+	p := profiles[0]
+	if p.Country() != c {
+		t.Error("Profile does not resolve back to its country.")
+	}
+	if p.AdvancementLevel() == nil || p.AdvancementLevel().Name != "Moderate Advancement" {
+		t.Error("Invalid AdvancementLevel: ", p.AdvancementLevel())
+	}
+	if len(p.Stats()) != 1 || len(p.Data()) != 1 {
+		t.Error("Invalid stats or data: ", p.Stats(), p.Data())
+	}
+
+	goods := p.Goods()
+	if len(goods) != 2 {
+		t.Fatal("Invalid goods count: ", len(goods))
+	}
+
+	g := goods[0].Good()
+	if g == nil || g.Name != "Bricks" {
+		t.Fatal("Invalid Good: ", g)
+	}
+	if g.Sector() == nil || g.Sector().Name != "Manufacturing" {
+		t.Error("Invalid Sector: ", g.Sector())
+	}
+	if len(g.CountryGoods()) != 1 || g.CountryGoods()[0].Profile() != p {
+		t.Error("Good does not resolve back to its profile.")
+	}
+
+	actions := p.SuggestedActions()
+	if len(actions) != 2 || actions[0].Area() == nil || actions[0].Area().Name != "Legal Framework" {
+		t.Error("Invalid suggested actions: ", actions)
+	}
+
+	if len(idx.CountriesInRegion(2)) != 1 || len(idx.GoodsInSector(1)) != 2 {
+		t.Error("Invalid reverse lookups.")
+	}
+}
+
+func TestIndexDangling(t *testing.T) {
+	ds := loadTestDataset(t)
+	ds.CountryGoods = append(ds.CountryGoods, CountryGood{CountryProfileID: 1, GoodID: 99})
+	ds.Countries[1].RegionID = 42
+
+	idx := NewIndex(ds)
+
+	d := idx.Dangling()
+	if len(d) != 2 {
+		t.Fatal("Invalid dangling references: ", d)
+	}
+
+	if d[0] != (DanglingRef{Model: "Country", Position: 1, Field: "region_id", Value: 42}) {
+		t.Error("Invalid dangling reference: ", d[0])
+	}
+
+	if d[1] != (DanglingRef{Model: "CountryGood", Position: 2, Field: "good_id", Value: 99}) {
+		t.Error("Invalid dangling reference: ", d[1])
+	}
+
+	if idx.Country(2).Region() != nil {
+		t.Error("Dangling region resolved.")
+	}
+}
@@ -0,0 +1,287 @@
+package laborstats
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// accentReplacer folds accented Latin letters to their unaccented forms.
+var accentReplacer = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a", "ā", "a", "ă", "a", "ą", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"ď", "d", "đ", "d",
+	"è", "e", "é", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e", "ě", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ī", "i", "į", "i",
+	"ñ", "n", "ń", "n", "ň", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o", "ø", "o", "ō", "o", "ő", "o",
+	"ř", "r",
+	"ś", "s", "š", "s", "ş", "s", "ș", "s",
+	"ť", "t", "ţ", "t", "ț", "t",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ū", "u", "ů", "u", "ű", "u",
+	"ý", "y", "ÿ", "y",
+	"ź", "z", "ż", "z", "ž", "z",
+	"æ", "ae", "œ", "oe", "ß", "ss",
+	"&", " and ",
+)
+
+// countryAliases groups normalized names which refer to the same country.
+var countryAliases = [][]string{
+	{"cote divoire", "ivory coast"},
+	{"burma", "myanmar"},
+	{"congo democratic republic of the", "democratic republic of the congo", "dr congo", "drc", "congo kinshasa"},
+	{"congo republic of the", "republic of the congo", "congo brazzaville"},
+	{"timor leste", "east timor"},
+	{"eswatini", "swaziland"},
+	{"kyrgyz republic", "kyrgyzstan"},
+	{"cabo verde", "cape verde"},
+	{"north macedonia", "macedonia"},
+	{"west bank and gaza strip", "west bank and gaza", "palestine", "palestinian territories"},
+	{"gambia the", "the gambia", "gambia"},
+	{"bahamas the", "the bahamas", "bahamas"},
+	{"lao pdr", "laos", "lao peoples democratic republic"},
+	{"turkiye", "turkey"},
+}
+
+// normalizeName folds case, accents and punctuation so that spelling
+// variants of a name compare equal, e.g. "Côte d'Ivoire" and "cote divoire".
+func normalizeName(name string) string {
+	name = accentReplacer.Replace(strings.ToLower(name))
+
+	var b strings.Builder
+	space := false
+
+	for _, r := range name {
+		switch {
+		case r == '\'' || r == '’' || r == '.':
+			// Dropped so that "d'Ivoire" and "St." fold into their neighbours.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		default:
+			space = true
+		}
+	}
+
+	return b.String()
+}
+
+// buildLookup indexes countries by ISO code and normalized name, and goods
+// by normalized name.
+func (idx *Index) buildLookup() {
+	idx.byISO2 = make(map[string]*CountryNode)
+	idx.byISO3 = make(map[string]*CountryNode)
+	idx.byName = make(map[string]*CountryNode)
+	idx.goodsByName = make(map[string]*GoodNode)
+
+	for _, g := range idx.goodList {
+		idx.goodsByName[normalizeName(g.Name)] = g
+	}
+
+	for _, c := range idx.countryList {
+		if c.ISO2 != "" {
+			idx.byISO2[strings.ToUpper(c.ISO2)] = c
+		}
+		if c.ISO3 != "" {
+			idx.byISO3[strings.ToUpper(c.ISO3)] = c
+		}
+		idx.byName[normalizeName(c.Name)] = c
+	}
+
+	for _, group := range countryAliases {
+		var match *CountryNode
+		for _, name := range group {
+			if c, ok := idx.byName[name]; ok {
+				match = c
+				break
+			}
+		}
+
+		if match == nil {
+			continue
+		}
+
+		for _, name := range group {
+			if _, ok := idx.byName[name]; !ok {
+				idx.byName[name] = match
+			}
+		}
+	}
+}
+
+// CountryByISO2 returns the country with the given ISO 3166-1 alpha-2 code,
+// or nil. Codes are case-insensitive.
+func (idx *Index) CountryByISO2(code string) *CountryNode {
+	return idx.byISO2[strings.ToUpper(strings.TrimSpace(code))]
+}
+
+// CountryByISO3 returns the country with the given ISO 3166-1 alpha-3 code,
+// or nil. Codes are case-insensitive.
+func (idx *Index) CountryByISO3(code string) *CountryNode {
+	return idx.byISO3[strings.ToUpper(strings.TrimSpace(code))]
+}
+
+// CountryByName returns the country matching name, or nil. Names are
+// compared ignoring case, accents and punctuation, and common alternative
+// names are recognised. If there is no such match, the country whose name is
+// closest to name is returned, provided it is close enough to be an
+// unambiguous misspelling.
+func (idx *Index) CountryByName(name string) *CountryNode {
+	norm := normalizeName(name)
+	if norm == "" {
+		return nil
+	}
+
+	if c, ok := idx.byName[norm]; ok {
+		return c
+	}
+
+	return closestName(norm, idx.byName)
+}
+
+// closestName returns the value whose name is closest to norm, provided it
+// is close enough to be an unambiguous misspelling, or nil.
+func closestName[N comparable](norm string, names map[string]N) N {
+	var best, none N
+
+	maxDist := len([]rune(norm)) / 4
+	if maxDist == 0 {
+		return none
+	}
+
+	bestDist, ties := maxDist+1, 0
+
+	for candidate, n := range names {
+		d := levenshtein(norm, candidate)
+		switch {
+		case d < bestDist:
+			best, bestDist, ties = n, d, 0
+		case d == bestDist && n != best:
+			ties++
+		}
+	}
+
+	if ties > 0 {
+		return none
+	}
+
+	return best
+}
+
+// LookupCountry returns the country identified by query, which may be a
+// numeric ID, an ISO 3166-1 alpha-2 or alpha-3 code, or a name as accepted by
+// CountryByName. It returns nil if no country matches.
+func (idx *Index) LookupCountry(query string) *CountryNode {
+	query = strings.TrimSpace(query)
+
+	if id, err := strconv.Atoi(query); err == nil {
+		return idx.Country(id)
+	}
+
+	switch len(query) {
+	case 2:
+		if c := idx.CountryByISO2(query); c != nil {
+			return c
+		}
+	case 3:
+		if c := idx.CountryByISO3(query); c != nil {
+			return c
+		}
+	}
+
+	return idx.CountryByName(query)
+}
+
+// GoodByName returns the good matching name, or nil. Names are compared as
+// in CountryByName, and singular and plural forms such as "Brick" and
+// "Bricks" match each other.
+func (idx *Index) GoodByName(name string) *GoodNode {
+	norm := normalizeName(name)
+	if norm == "" {
+		return nil
+	}
+
+	for _, variant := range []string{norm, norm + "s", strings.TrimSuffix(norm, "s")} {
+		if g, ok := idx.goodsByName[variant]; ok {
+			return g
+		}
+	}
+
+	return closestName(norm, idx.goodsByName)
+}
+
+// LookupGood returns the good identified by query, which may be a numeric ID
+// or a name as accepted by GoodByName. It returns nil if no good matches.
+func (idx *Index) LookupGood(query string) *GoodNode {
+	query = strings.TrimSpace(query)
+
+	if id, err := strconv.Atoi(query); err == nil {
+		return idx.Good(id)
+	}
+
+	return idx.GoodByName(query)
+}
+
+// Profile returns the country's profile for year, or nil.
+func (c *CountryNode) Profile(year int) *CountryProfileNode {
+	for _, p := range c.Profiles() {
+		if p.ProfileYear == year {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Stats returns the statistics of all of the country's profiles, ordered by
+// profile year.
+func (c *CountryNode) Stats() []CountryStat {
+	var stats []CountryStat
+	for _, p := range c.Profiles() {
+		stats = append(stats, p.Stats()...)
+	}
+
+	return stats
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// minInt returns the smallest of a, b and c.
+func minInt(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+
+	return a
+}
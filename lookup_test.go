@@ -0,0 +1,113 @@
+package laborstats
+
+import "testing"
+
+func newLookupIndex() *Index {
+	return NewIndex(&Dataset{
+		Countries: []Country{
+			{ID: 1, Name: "Bangladesh", ISO2: "BD", ISO3: "BGD"},
+			{ID: 2, Name: "Côte d'Ivoire", ISO2: "CI", ISO3: "CIV"},
+			{ID: 3, Name: "Burma", ISO2: "MM", ISO3: "MMR"},
+			{ID: 4, Name: "Congo, Democratic Republic of the", ISO2: "CD", ISO3: "COD"},
+			{ID: 5, Name: "Congo, Republic of the", ISO2: "CG", ISO3: "COG"},
+			{ID: 6, Name: "São Tomé and Príncipe", ISO2: "ST", ISO3: "STP"},
+		},
+		CountryProfiles: []CountryProfile{
+			{ID: 10, CountryID: 1, ProfileYear: 2014},
+			{ID: 11, CountryID: 1, ProfileYear: 2013},
+		},
+		CountryStats: []CountryStat{
+			{CountryProfileID: 10, CWPopulation: NullInt{Int: 2, Valid: true}},
+			{CountryProfileID: 11, CWPopulation: NullInt{Int: 1, Valid: true}},
+		},
+	})
+}
+
+func TestNormalizeName(t *testing.T) {
+	cases := map[string]string{
+		"Côte d'Ivoire":                     "cote divoire",
+		"  COTE D’IVOIRE ":                  "cote divoire",
+		"Congo, Democratic Republic of the": "congo democratic republic of the",
+		"Bosnia & Herzegovina":              "bosnia and herzegovina",
+		"Timor-Leste":                       "timor leste",
+	}
+
+	for in, expected := range cases {
+		if out := normalizeName(in); out != expected {
+			t.Errorf("normalizeName(%q) = %q, expected %q", in, out, expected)
+		}
+	}
+}
+
+func TestCountryLookup(t *testing.T) {
+	idx := newLookupIndex()
+
+	cases := map[string]int{
+		"1":                     1,
+		"bd":                    1,
+		"BGD":                   1,
+		"Bangladesh":            1,
+		"Bangladseh":            1,
+		"Cote d'Ivoire":         2,
+		"Ivory Coast":           2,
+		"Myanmar":               3,
+		"DRC":                   4,
+		"Congo-Brazzaville":     5,
+		"Sao Tome and Principe": 6,
+	}
+
+	for query, id := range cases {
+		c := idx.LookupCountry(query)
+		if c == nil || c.ID != id {
+			t.Errorf("LookupCountry(%q) = %v, expected country %d", query, c, id)
+		}
+	}
+
+	for _, query := range []string{"", "XX", "99", "Atlantis", "Congo"} {
+		if c := idx.LookupCountry(query); c != nil {
+			t.Errorf("LookupCountry(%q) = %v, expected no match", query, c.Name)
+		}
+	}
+}
+
+func TestCountryProfileAndStats(t *testing.T) {
+	c := newLookupIndex().CountryByISO3("BGD")
+
+	p := c.Profile(2014)
+	if p == nil || p.ID != 10 {
+		t.Error("Invalid 2014 profile: ", p)
+	}
+
+	if c.Profile(2010) != nil {
+		t.Error("Profile returned for missing year.")
+	}
+
+	stats := c.Stats()
+	if len(stats) != 2 || stats[0].CWPopulation.Int != 1 || stats[1].CWPopulation.Int != 2 {
+		t.Error("Invalid stats: ", stats)
+	}
+}
+
+func TestLookupGood(t *testing.T) {
+	idx := newRiskIndex()
+
+	cases := map[string]string{
+		"3":        "Bricks",
+		"Bricks":   "Bricks",
+		" brick ":  "Bricks",
+		"GARMENT":  "Garments",
+		"Cofee":    "Coffee",
+		"Tungsten": "",
+		"":         "",
+	}
+
+	for in, expected := range cases {
+		g := idx.LookupGood(in)
+		switch {
+		case expected == "" && g != nil:
+			t.Errorf("LookupGood(%q) = %s, expected nil", in, g.Name)
+		case expected != "" && (g == nil || g.Name != expected):
+			t.Errorf("LookupGood(%q) = %v, expected %s", in, g, expected)
+		}
+	}
+}
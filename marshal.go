@@ -0,0 +1,85 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// marshalOmitZero encodes the struct v as encoding/json does, except that
+// fields tagged omitempty are left out whenever they hold their zero value,
+// including fields of struct types such as NullFloat which encoding/json
+// never omits.
+func marshalOmitZero(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		opts := strings.Split(f.Tag.Get("json"), ",")
+		name := opts[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		if containsString(opts[1:], "omitempty") && rv.Field(i).IsZero() {
+			continue
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		if err := writeJSON(&buf, name); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := writeJSON(&buf, rv.Field(i).Interface()); err != nil {
+			return nil, err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// rawMatches reports whether raw, the JSON v was decoded from, still decodes
+// to v, so that v can be encoded as raw without losing any change made to
+// it since. Values which decoding went on to adjust, such as a statistic
+// marked missing, also match if one of adjust gives v.
+func rawMatches[T comparable, P interface {
+	*T
+	json.Unmarshaler
+}](v T, raw string, adjust ...func(T) T) bool {
+	if raw == "" {
+		return false
+	}
+
+	var decoded T
+	if err := P(&decoded).UnmarshalJSON([]byte(raw)); err != nil {
+		return false
+	}
+
+	if decoded == v {
+		return true
+	}
+
+	for _, f := range adjust {
+		if f(decoded) == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,26 @@
+package laborstats
+
+import "testing"
+
+func TestRawMatches(t *testing.T) {
+	decoded := NullFloat{Float64: 0, Valid: true, Raw: `"0"`}
+
+	if !rawMatches(decoded, decoded.Raw) {
+		t.Error("Decoded value does not match its raw JSON.")
+	}
+
+	missing := decoded.missingIfZero()
+	if rawMatches(missing, missing.Raw) || !rawMatches(missing, missing.Raw, NullFloat.missingIfZero) {
+		t.Error("Adjusted value not matched through adjust only.")
+	}
+
+	edited := decoded
+	edited.Float64 = 3
+	if rawMatches(edited, edited.Raw, NullFloat.missingIfZero) {
+		t.Error("Edited value matches its raw JSON.")
+	}
+
+	if rawMatches(NullFloat{}, "") || rawMatches(NullFloat{}, "{") {
+		t.Error("Empty or invalid raw JSON matched.")
+	}
+}
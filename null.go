@@ -0,0 +1,135 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NullFloat is a statistic which may be missing. The API reports missing
+// statistics as null, as an empty or non-numeric string such as "N/A", or
+// as a negative sentinel; all of these decode with Valid false, so that a
+// missing value is never mistaken for zero.
+type NullFloat struct {
+	Float64 float64
+	// Valid is true if the API reported a value.
+	Valid bool
+	// Raw is the JSON returned by the API, e.g. `7.5` or `"N/A"`.
+	Raw string
+}
+
+func (n NullFloat) String() string {
+	if !n.Valid {
+		return ""
+	}
+
+	return strconv.FormatFloat(n.Float64, 'f', -1, 64)
+}
+
+// UnmarshalJSON decodes a statistic from a JSON number, string or null.
+func (n *NullFloat) UnmarshalJSON(b []byte) error {
+	raw, ok, err := nullNumber(b)
+	if err != nil {
+		return err
+	}
+
+	*n = NullFloat{Raw: string(bytes.TrimSpace(b))}
+
+	f, err := strconv.ParseFloat(raw, 64)
+	if ok && err == nil && f >= 0 {
+		n.Float64, n.Valid = f, true
+	}
+
+	return nil
+}
+
+// missingIfZero returns the statistic marked missing if its value is zero.
+func (n NullFloat) missingIfZero() NullFloat {
+	if n.Valid && n.Float64 == 0 {
+		n.Valid = false
+	}
+
+	return n
+}
+
+// MarshalJSON encodes the statistic as the JSON returned by the API, so long
+// as it has not been changed since. A zero figure that was marked missing
+// also keeps its raw value. Other statistics are encoded as a JSON number,
+// or null if missing.
+func (n NullFloat) MarshalJSON() ([]byte, error) {
+	if rawMatches(n, n.Raw, NullFloat.missingIfZero) {
+		return []byte(n.Raw), nil
+	}
+
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Float64)
+}
+
+// NullInt is a count which may be missing, decoded as NullFloat is.
+type NullInt struct {
+	Int int
+	// Valid is true if the API reported a value.
+	Valid bool
+	// Raw is the JSON returned by the API, e.g. `673949` or `"1,234"`.
+	Raw string
+}
+
+func (n NullInt) String() string {
+	if !n.Valid {
+		return ""
+	}
+
+	return strconv.Itoa(n.Int)
+}
+
+// UnmarshalJSON decodes a count from a JSON number, string or null. Counts
+// reported with a fractional part are truncated.
+func (n *NullInt) UnmarshalJSON(b []byte) error {
+	raw, ok, err := nullNumber(b)
+	if err != nil {
+		return err
+	}
+
+	*n = NullInt{Raw: string(bytes.TrimSpace(b))}
+
+	f, err := strconv.ParseFloat(raw, 64)
+	if ok && err == nil && f >= 0 {
+		n.Int, n.Valid = int(f), true
+	}
+
+	return nil
+}
+
+// MarshalJSON writes Raw if Int and Valid are unchanged since decoding. A
+// count built or edited in code is written as a JSON number, or null.
+func (n NullInt) MarshalJSON() ([]byte, error) {
+	if rawMatches(n, n.Raw) {
+		return []byte(n.Raw), nil
+	}
+
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Int)
+}
+
+// nullNumber returns the text of a JSON number or string holding a number,
+// with thousands separators removed. ok is false for null.
+func nullNumber(b []byte) (raw string, ok bool, err error) {
+	raw, isNull, err := decodeScalar(b)
+	if err != nil {
+		return "", false, fmt.Errorf("Invalid number: %s", b)
+	}
+
+	if isNull {
+		return "", false, nil
+	}
+
+	return strings.TrimSpace(strings.ReplaceAll(raw, ",", "")), true, nil
+}
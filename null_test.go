@@ -0,0 +1,124 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullFloatUnmarshalJSON(t *testing.T) {
+	cases := map[string]NullFloat{
+		`7.5`:     {7.5, true, `7.5`},
+		`0`:       {0, true, `0`},
+		`"41.8"`:  {41.8, true, `"41.8"`},
+		`"1,250"`: {1250, true, `"1,250"`},
+		`null`:    {Raw: `null`},
+		`""`:      {Raw: `""`},
+		`"N/A"`:   {Raw: `"N/A"`},
+		`-1`:      {Raw: `-1`},
+		`"-99"`:   {Raw: `"-99"`},
+	}
+
+	for in, expected := range cases {
+		var n NullFloat
+		if err := json.Unmarshal([]byte(in), &n); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if n != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, n, expected)
+		}
+	}
+
+	var n NullFloat
+	if err := json.Unmarshal([]byte(`true`), &n); err == nil {
+		t.Error("Boolean accepted as number.")
+	}
+}
+
+func TestNullIntUnmarshalJSON(t *testing.T) {
+	cases := map[string]NullInt{
+		`673949`:   {673949, true, `673949`},
+		`"23,665"`: {23665, true, `"23,665"`},
+		`0`:        {0, true, `0`},
+		`null`:     {Raw: `null`},
+		`""`:       {Raw: `""`},
+		`-1`:       {Raw: `-1`},
+	}
+
+	for in, expected := range cases {
+		var n NullInt
+		if err := json.Unmarshal([]byte(in), &n); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if n != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, n, expected)
+		}
+	}
+}
+
+func TestNullRoundTrip(t *testing.T) {
+	for _, in := range []string{`12.5`, `"12.5"`, `"1,234"`, `"N/A"`, `""`, `-1`, `"-99"`, `null`} {
+		var f NullFloat
+		var i NullInt
+		if err := json.Unmarshal([]byte(in), &f); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(in), &i); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, v := range []interface{}{f, i} {
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != in {
+				t.Errorf("Round trip of %s gave %s", in, b)
+			}
+		}
+	}
+}
+
+func TestNullMarshalJSON(t *testing.T) {
+	b, err := json.Marshal([]interface{}{
+		NullFloat{Float64: 7.5, Valid: true},
+		NullFloat{Float64: 0, Valid: true},
+		NullFloat{},
+		NullInt{Int: 42, Valid: true},
+		NullInt{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `[7.5,0,null,42,null]` {
+		t.Error("Invalid marshaled values: ", string(b))
+	}
+}
+
+func TestNullMarshalEdited(t *testing.T) {
+	var f NullFloat
+	var i NullInt
+	if err := json.Unmarshal([]byte(`"5"`), &f); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`"1,234"`), &i); err != nil {
+		t.Fatal(err)
+	}
+
+	f.Float64 = 9
+	i.Valid = false
+
+	b, err := json.Marshal([]interface{}{f, i, NullFloat{Raw: `0`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `[9,null,0]` {
+		t.Error("Edited values not encoded: ", string(b))
+	}
+}
@@ -0,0 +1,171 @@
+package laborstats
+
+import "context"
+
+// Number of results requested per page when the filter sets no limit.
+const defaultPageSize = 100
+
+// Pager iterates over the results of an endpoint one page at a time, using
+// limit and offset filters to request each page. Iteration stops when the
+// API returns a page shorter than the page size.
+//
+//	p := api.CountryGoods(ctx)
+//	for p.Next() {
+//		for _, good := range p.Page() {
+//			...
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	api      *LaborStatsAPI
+	ctx      context.Context
+	query    func(*LaborStatsAPI, context.Context) ([]T, error)
+	pageSize int
+	offset   int
+	page     []T
+	done     bool
+	err      error
+}
+
+// newPager returns a pager which runs query with the filters set on api. A
+// limit set on the filters, including one added with AddFilter, is used as
+// the page size, and an offset as the position of the first page.
+func newPager[T any](ctx context.Context, api *LaborStatsAPI, query func(*LaborStatsAPI, context.Context) ([]T, error)) *Pager[T] {
+	p := &Pager[T]{
+		api:      api,
+		ctx:      ctx,
+		query:    query,
+		pageSize: defaultPageSize,
+	}
+
+	cfg, err := api.requestConfig()
+	if err != nil {
+		p.err = err
+		return p
+	}
+
+	limit, offset, err := cfg.filter.pageBounds()
+	if err != nil {
+		p.err = err
+		return p
+	}
+
+	if limit > 0 {
+		p.pageSize = limit
+	}
+	p.offset = offset
+
+	return p
+}
+
+// Next requests the next page of results. It returns false when there are
+// no more results or an error occurred.
+func (p *Pager[T]) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	f := p.api.filter.merge(NewFilter().Limit(p.pageSize).Offset(p.offset))
+
+	page, err := p.query(p.api.WithFilter(f), p.ctx)
+	if err != nil {
+		p.err = err
+		p.page = nil
+		return false
+	}
+
+	if len(page) == 0 {
+		p.done = true
+		p.page = nil
+		return false
+	}
+
+	if len(page) < p.pageSize {
+		p.done = true
+	}
+
+	p.page = page
+	p.offset += len(page)
+
+	return true
+}
+
+// Page returns the results of the most recent call to Next.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All walks the remaining pages and returns their combined results. The
+// results fetched before an error are returned along with the error.
+func (p *Pager[T]) All() ([]T, error) {
+	var all []T
+
+	for p.Next() {
+		all = append(all, p.Page()...)
+	}
+
+	return all, p.Err()
+}
+
+// AdvancementLevels returns a pager over the Advancement Level endpoint.
+func (api *LaborStatsAPI) AdvancementLevels(ctx context.Context) *Pager[AdvancementLevel] {
+	return NewQuery(api, AdvancementLevelEndpoint).Pages(ctx)
+}
+
+// Countries returns a pager over the Country endpoint.
+func (api *LaborStatsAPI) Countries(ctx context.Context) *Pager[Country] {
+	return NewQuery(api, CountryEndpoint).Pages(ctx)
+}
+
+// CountryData returns a pager over the Country Data endpoint.
+func (api *LaborStatsAPI) CountryData(ctx context.Context) *Pager[CountryData] {
+	return NewQuery(api, CountryDataEndpoint).Pages(ctx)
+}
+
+// CountryGoods returns a pager over the Country Goods endpoint.
+func (api *LaborStatsAPI) CountryGoods(ctx context.Context) *Pager[CountryGood] {
+	return NewQuery(api, CountryGoodsEndpoint).Pages(ctx)
+}
+
+// CountryProfiles returns a pager over the Country Profile endpoint.
+func (api *LaborStatsAPI) CountryProfiles(ctx context.Context) *Pager[CountryProfile] {
+	return NewQuery(api, CountryProfileEndpoint).Pages(ctx)
+}
+
+// CountryStats returns a pager over the Country Statistics endpoint.
+func (api *LaborStatsAPI) CountryStats(ctx context.Context) *Pager[CountryStat] {
+	return NewQuery(api, CountryStatsEndpoint).Pages(ctx)
+}
+
+// Goods returns a pager over the "Good" endpoint.
+func (api *LaborStatsAPI) Goods(ctx context.Context) *Pager[Good] {
+	return NewQuery(api, GoodEndpoint).Pages(ctx)
+}
+
+// Regions returns a pager over the Region endpoint.
+func (api *LaborStatsAPI) Regions(ctx context.Context) *Pager[Region] {
+	return NewQuery(api, RegionEndpoint).Pages(ctx)
+}
+
+// Sectors returns a pager over the Sector endpoint.
+func (api *LaborStatsAPI) Sectors(ctx context.Context) *Pager[Sector] {
+	return NewQuery(api, SectorEndpoint).Pages(ctx)
+}
+
+// SuggestedActionAreas returns a pager over the Suggested Action Area
+// endpoint.
+func (api *LaborStatsAPI) SuggestedActionAreas(ctx context.Context) *Pager[SuggestedActionArea] {
+	return NewQuery(api, SuggestedActionAreaEndpoint).Pages(ctx)
+}
+
+// SuggestedActions returns a pager over the Suggested Actions endpoint.
+func (api *LaborStatsAPI) SuggestedActions(ctx context.Context) *Pager[SuggestedAction] {
+	return NewQuery(api, SuggestedActionEndpoint).Pages(ctx)
+}
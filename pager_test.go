@@ -0,0 +1,145 @@
+package laborstats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newPagingHandler serves total goods, honouring limit and offset path
+// filters.
+func newPagingHandler(total int, paths *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*paths = append(*paths, r.URL.Path)
+
+		segs := strings.Split(strings.TrimPrefix(r.URL.Path, "/"+goodURI+"/"), "/")
+		params := map[string]int{}
+		for i := 0; i+1 < len(segs); i += 2 {
+			params[segs[i]], _ = strconv.Atoi(segs[i+1])
+		}
+
+		var goods []Good
+		for id := params["offset"] + 1; id <= total && len(goods) < params["limit"]; id++ {
+			goods = append(goods, Good{ID: id, Name: "Good " + strconv.Itoa(id)})
+		}
+
+		if goods == nil {
+			goods = []Good{}
+		}
+
+		json.NewEncoder(w).Encode(goods)
+	})
+}
+
+func TestPagerAll(t *testing.T) {
+	var paths []string
+
+	a, stop := newTestAPI(t, newPagingHandler(7, &paths))
+	defer stop()
+
+	goods, err := a.WithFilter(NewFilter().Limit(3)).Goods(context.Background()).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(goods) != 7 {
+		t.Fatal("Invalid result length: ", len(goods))
+	}
+
+	for i, g := range goods {
+		if g.ID != i+1 {
+			t.Error("Invalid ID at position ", i, ": ", g.ID)
+		}
+	}
+
+	expected := []string{
+		"/" + goodURI + "/limit/3",
+		"/" + goodURI + "/limit/3/offset/3",
+		"/" + goodURI + "/limit/3/offset/6",
+	}
+
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Error("Invalid page requests: ", paths)
+	}
+}
+
+func TestPagerExactMultiple(t *testing.T) {
+	var paths []string
+
+	a, stop := newTestAPI(t, newPagingHandler(4, &paths))
+	defer stop()
+
+	p := a.WithFilter(NewFilter().Limit(2)).Goods(context.Background())
+
+	pages := 0
+	for p.Next() {
+		pages++
+		if len(p.Page()) != 2 {
+			t.Error("Invalid page length: ", len(p.Page()))
+		}
+	}
+
+	if p.Err() != nil {
+		t.Fatal(p.Err())
+	}
+
+	if pages != 2 || len(paths) != 3 {
+		t.Error("Invalid page count: ", pages, paths)
+	}
+}
+
+func TestPagerLegacyFilters(t *testing.T) {
+	var paths []string
+
+	a, stop := newTestAPI(t, newPagingHandler(7, &paths))
+	defer stop()
+
+	a.AddFilter("limit", "2")
+	a.AddFilter("offset", "3")
+
+	goods, err := a.Goods(context.Background()).All()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(goods) != 4 || goods[0].ID != 4 {
+		t.Error("Invalid results: ", goods)
+	}
+
+	expected := []string{
+		"/" + goodURI + "/limit/2/offset/3",
+		"/" + goodURI + "/limit/2/offset/5",
+		"/" + goodURI + "/limit/2/offset/7",
+	}
+
+	if strings.Join(paths, " ") != strings.Join(expected, " ") {
+		t.Error("Invalid page requests: ", paths)
+	}
+
+	a.AddFilter("limit", "all")
+
+	p := a.Goods(context.Background())
+	if p.Next() || !errors.Is(p.Err(), ErrInvalidFilter) {
+		t.Error("Expected ErrInvalidFilter, got: ", p.Err())
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	a := NewLaborStatsAPI(testAPIKey)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := a.Countries(ctx)
+	if p.Next() {
+		t.Error("Next succeeded with a canceled context.")
+	}
+
+	if p.Err() == nil {
+		t.Error("Expected an error from a canceled context.")
+	}
+}
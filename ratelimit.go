@@ -0,0 +1,136 @@
+package laborstats
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket which limits the rate of API requests. A
+// single limiter may be shared by any number of API instances and
+// goroutines, so that all requests made with one API key draw on the same
+// budget.
+type RateLimiter struct {
+	// FailFast makes requests fail with a *RateLimitError instead of waiting
+	// when the budget is exhausted. It must be set before the limiter is
+	// used.
+	FailFast bool
+
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// RateLimitError is returned when a request is refused by a RateLimiter
+// configured to fail fast.
+type RateLimitError struct {
+	// Delay is the time until a request would be allowed.
+	Delay time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("Client rate limit exceeded. Next request allowed in %s.", e.Delay)
+}
+
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// NewRateLimiter returns a limiter allowing perSecond requests per second on
+// average, with bursts of up to burst requests. A perSecond value of zero or
+// less disables the limit.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// Allow reports whether a request may be made now, and if so takes a token
+// from the budget.
+func (l *RateLimiter) Allow() bool {
+	_, ok := l.reserve(time.Now(), false)
+
+	return ok
+}
+
+// Wait takes a token from the budget, blocking until one is available or ctx
+// is done. If FailFast is set, Wait returns a *RateLimitError rather than
+// blocking.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay, ok := l.reserve(time.Now(), !l.FailFast)
+	if !ok {
+		return &RateLimitError{Delay: delay}
+	}
+
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+
+	return nil
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// using it. If no token is available and block is false, no token is taken
+// and ok is false.
+func (l *RateLimiter) reserve(now time.Time, block bool) (delay time.Duration, ok bool) {
+	if l.rate <= 0 {
+		return 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Callers read the clock before taking the lock, so now may be earlier
+	// than a time already accounted for. last only moves forward, so that no
+	// interval is refilled twice.
+	switch {
+	case l.last.IsZero():
+		l.last = now
+	case now.After(l.last):
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+
+	delay = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+	if !block {
+		return delay, false
+	}
+
+	l.tokens--
+
+	return delay, true
+}
+
+// cancel returns a reserved token that was not used.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
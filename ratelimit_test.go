@@ -0,0 +1,118 @@
+package laborstats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(2, 2)
+	now := time.Date(2015, 10, 25, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.reserve(now, false); !ok {
+			t.Fatal("Burst token refused: ", i)
+		}
+	}
+
+	delay, ok := l.reserve(now, false)
+	if ok {
+		t.Fatal("Token granted beyond burst.")
+	}
+	if delay != 500*time.Millisecond {
+		t.Error("Invalid delay: ", delay)
+	}
+
+	if _, ok := l.reserve(now.Add(500*time.Millisecond), false); !ok {
+		t.Error("Token not refilled.")
+	}
+
+	delay, ok = l.reserve(now.Add(500*time.Millisecond), true)
+	if !ok || delay != 500*time.Millisecond {
+		t.Error("Invalid blocking reservation: ", delay, ok)
+	}
+}
+
+func TestRateLimiterOutOfOrder(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	now := time.Date(2015, 10, 25, 0, 0, 0, 0, time.UTC)
+
+	for _, d := range []time.Duration{0, time.Second} {
+		if _, ok := l.reserve(now.Add(d), false); !ok {
+			t.Fatal("Token refused at: ", d)
+		}
+	}
+
+	if _, ok := l.reserve(now.Add(500*time.Millisecond), false); ok {
+		t.Error("Token granted for an earlier time.")
+	}
+
+	if _, ok := l.reserve(now.Add(1500*time.Millisecond), false); ok {
+		t.Error("Token refilled for time already accounted for.")
+	}
+
+	if _, ok := l.reserve(now.Add(2*time.Second), false); !ok {
+		t.Error("Token not refilled.")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l := NewRateLimiter(0, 1)
+
+	for i := 0; i < 100; i++ {
+		if !l.Allow() {
+			t.Fatal("Unlimited limiter refused a request.")
+		}
+	}
+}
+
+func TestRateLimiterSharedFailFast(t *testing.T) {
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer stop()
+
+	l := NewRateLimiter(0.001, 2)
+	l.FailFast = true
+
+	a.RateLimiter = l
+	a.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	if _, err := a.QueryRegion(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.QuerySector(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := a.QueryGood()
+
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
+		t.Fatal("Expected RateLimitError, got: ", err)
+	}
+
+	var re *RetryError
+	if errors.As(err, &re) && re.Attempts != 1 {
+		t.Error("Rate limit error was retried: ", re.Attempts)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+	l.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected deadline error, got: ", err)
+	}
+
+	if l.tokens < -0.01 {
+		t.Error("Canceled reservation not returned: ", l.tokens)
+	}
+}
@@ -0,0 +1,103 @@
+package laborstats
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Ratification is the state of a country's ratification of a convention.
+type Ratification int
+
+const (
+	RatificationUnknown Ratification = iota
+	Ratified
+	NotRatified
+)
+
+func (r Ratification) String() string {
+	switch r {
+	case Ratified:
+		return "Ratified"
+	case NotRatified:
+		return "Not Ratified"
+	}
+
+	return "Unknown"
+}
+
+// RatificationStatus is a ratification state decoded from the API. The API
+// reports ratifications as free text such as "Yes", "No" or "N/A"; values
+// which are not recognised decode as RatificationUnknown.
+type RatificationStatus struct {
+	State Ratification
+	// Raw is the status as sent, e.g. `"Yes"`, `1` or `true`.
+	Raw string
+}
+
+// Ratified reports whether the convention has been ratified.
+func (s RatificationStatus) Ratified() bool {
+	return s.State == Ratified
+}
+
+func (s RatificationStatus) String() string {
+	return s.State.String()
+}
+
+// UnmarshalJSON decodes a ratification from a JSON string, number, boolean
+// or null.
+func (s *RatificationStatus) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+
+	raw := string(b)
+	if raw != "true" && raw != "false" {
+		var err error
+		if raw, _, err = decodeScalar(b); err != nil {
+			return fmt.Errorf("Invalid ratification status: %s", b)
+		}
+	}
+
+	*s = RatificationStatus{State: parseRatification(raw), Raw: string(b)}
+
+	return nil
+}
+
+// MarshalJSON writes Raw for a status whose State has not been changed
+// since decoding. Other statuses are written as "Yes", "No" or null.
+func (s RatificationStatus) MarshalJSON() ([]byte, error) {
+	if rawMatches(s, s.Raw) {
+		return []byte(s.Raw), nil
+	}
+
+	switch s.State {
+	case Ratified:
+		return []byte(`"Yes"`), nil
+	case NotRatified:
+		return []byte(`"No"`), nil
+	}
+
+	return []byte("null"), nil
+}
+
+func parseRatification(raw string) Ratification {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "yes", "y", "ratified", "true", "1":
+		return Ratified
+	case "no", "n", "not ratified", "false", "0":
+		return NotRatified
+	}
+
+	return RatificationUnknown
+}
+
+// Convention identifies an international instrument tracked in CountryData.
+type Convention string
+
+const (
+	ConventionC138    Convention = "ILO C. 138, Minimum Age"
+	ConventionC182    Convention = "ILO C. 182, Worst Forms of Child Labor"
+	ConventionCRC     Convention = "UN CRC"
+	ConventionCRCCSA  Convention = "UN CRC Optional Protocol on the Sale of Children, Child Prostitution and Child Pornography"
+	ConventionCRCAC   Convention = "UN CRC Optional Protocol on Armed Conflict"
+	ConventionPalermo Convention = "Palermo Protocol on Trafficking in Persons"
+)
@@ -0,0 +1,57 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRatificationStatusUnmarshalJSON(t *testing.T) {
+	cases := map[string]RatificationStatus{
+		`"Yes"`:         {Ratified, `"Yes"`},
+		`" yes "`:       {Ratified, `" yes "`},
+		`"No"`:          {NotRatified, `"No"`},
+		`"N/A"`:         {RatificationUnknown, `"N/A"`},
+		`""`:            {RatificationUnknown, `""`},
+		`null`:          {RatificationUnknown, `null`},
+		`1`:             {Ratified, `1`},
+		`false`:         {NotRatified, `false`},
+		`"Signed only"`: {RatificationUnknown, `"Signed only"`},
+	}
+
+	for in, expected := range cases {
+		var s RatificationStatus
+		if err := json.Unmarshal([]byte(in), &s); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if s != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, s, expected)
+		}
+	}
+
+	var s RatificationStatus
+	if err := json.Unmarshal([]byte(`{}`), &s); err == nil {
+		t.Error("Object accepted as ratification status.")
+	}
+}
+
+func TestRatificationStatusMarshalJSON(t *testing.T) {
+	cases := map[string]RatificationStatus{
+		`"Yes"`: {Ratified, `"Yes"`},
+		`"N/A"`: {RatificationUnknown, `"N/A"`},
+		`"No"`:  {State: NotRatified},
+		`null`:  {},
+	}
+
+	for expected, s := range cases {
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != expected {
+			t.Errorf("Marshal(%+v) = %s, expected %s", s, b, expected)
+		}
+	}
+}
@@ -8,10 +8,7 @@ func TestRegionUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := RegionAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := RegionEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -0,0 +1,175 @@
+package laborstats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryPolicy is a retry policy suitable for batch jobs against the
+// public API.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// RetryPolicy controls how failed API requests are retried. Transport errors
+// and 429, 500, 502, 503 and 504 responses are retried; other failures are
+// returned immediately. Only idempotent requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 1 are treated as 1.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles with each
+	// further attempt, and a random jitter of up to half the delay is
+	// subtracted.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed delay. A Retry-After header sent by the API
+	// takes precedence over the computed delay, and is not capped.
+	MaxDelay time.Duration
+}
+
+// RetryError is returned when a request fails while a retry policy is set.
+// It records the number of attempts made.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("Request failed after %d attempt(s): %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+func (p RetryPolicy) normalize() RetryPolicy {
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+
+	if p.MaxDelay > 0 && p.BaseDelay > p.MaxDelay {
+		p.BaseDelay = p.MaxDelay
+	}
+
+	return p
+}
+
+// backoff returns the delay before the retry following attempt. A positive
+// retryAfter overrides the computed delay.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if delay > 1 {
+		delay -= time.Duration(rand.Int63n(int64(delay / 2)))
+	}
+
+	return delay
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// isRetryable reports whether err is a transient failure. Errors caused by
+// ctx ending are never retried.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return isRetryableStatus(ae.StatusCode)
+	}
+
+	var rle *RateLimitError
+	if errors.As(err, &rle) {
+		return false
+	}
+
+	// The remaining errors are failures to send the request or read the
+	// response.
+	return true
+}
+
+func retryAfter(err error) time.Duration {
+	var ae *APIError
+	if errors.As(err, &ae) {
+		return ae.RetryAfter
+	}
+
+	return 0
+}
+
+// parseRetryAfter parses a Retry-After header value given either as a
+// number of seconds or as an HTTP date.
+func parseRetryAfter(val string, now time.Time) time.Duration {
+	if val == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(val); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(val); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+
+	return 0
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,141 @@
+package laborstats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryTransientStatus(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/region.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Write(dataMock)
+		}
+	}))
+	defer stop()
+
+	a.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	result, err := a.QueryRegion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result) != 2 || calls != 3 {
+		t.Error("Invalid retry behaviour: ", len(result), calls)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	calls := 0
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer stop()
+
+	a.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	_, err := a.QueryRegion()
+
+	var re *RetryError
+	if !errors.As(err, &re) {
+		t.Fatal("Expected RetryError, got: ", err)
+	}
+
+	if re.Attempts != 3 || calls != 3 {
+		t.Error("Invalid attempt count: ", re.Attempts, calls)
+	}
+
+	if !errors.Is(err, ErrInvalidResponse) {
+		t.Error("RetryError does not wrap the last failure: ", err)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	dataMock, err := getDataMock("./testdata/error.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write(dataMock)
+	}))
+	defer stop()
+
+	a.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	_, err = a.QueryRegion()
+	if !errors.Is(err, LaborStatsAPIError) {
+		t.Error("Expected API error, got: ", err)
+	}
+
+	if calls != 1 {
+		t.Error("API error was retried: ", calls)
+	}
+}
+
+func TestRetryContextCanceledDuringBackoff(t *testing.T) {
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer stop()
+
+	a.Retry = &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := a.QueryRegionContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected deadline error, got: ", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}.normalize()
+
+	for attempt := 1; attempt < 10; attempt++ {
+		d := p.backoff(attempt, 0)
+		if d <= 0 || d > time.Second {
+			t.Error("Delay out of range for attempt ", attempt, ": ", d)
+		}
+	}
+
+	if d := p.backoff(1, 5*time.Second); d != 5*time.Second {
+		t.Error("Retry-After not honoured: ", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+
+	if d := parseRetryAfter("120", now); d != 2*time.Minute {
+		t.Error("Invalid delay from seconds: ", d)
+	}
+
+	if d := parseRetryAfter("Wed, 21 Oct 2015 07:28:30 GMT", now); d != 30*time.Second {
+		t.Error("Invalid delay from HTTP date: ", d)
+	}
+
+	if d := parseRetryAfter("soon", now); d != 0 {
+		t.Error("Invalid delay from garbage: ", d)
+	}
+}
@@ -0,0 +1,118 @@
+package laborstats
+
+import "sort"
+
+// GoodRisk is a good flagged on a country profile, with its names resolved.
+type GoodRisk struct {
+	GoodID   int
+	Good     string
+	SectorID int
+	// Sector is the name of the good's sector, or "" if it is unknown.
+	Sector           string
+	ChildLabor       bool
+	ForcedLabor      bool
+	ForcedChildLabor bool
+}
+
+// CountryRisk summarises the goods flagged on a country's profile.
+type CountryRisk struct {
+	Country *CountryNode
+	// ProfileYear is the year of the profile the goods were listed on.
+	ProfileYear int
+	// Goods lists the flagged goods ordered by name.
+	Goods []GoodRisk
+	// SectorCounts maps sector names to the number of flagged goods in each.
+	SectorCounts map[string]int
+	// Rank is the country's position when ordered by number of flagged goods,
+	// starting at 1. Countries with equal numbers share a rank.
+	Rank int
+}
+
+// Flagged reports whether the good is listed as produced with child labor,
+// forced labor or forced child labor.
+func (cg *CountryGoodNode) Flagged() bool {
+	return cg.ChildLabor.True() || cg.ForcedLabor.True() || cg.ForcedChildLabor.True()
+}
+
+// Risk returns the goods flagged on the country's most recent profile which
+// lists any goods, or nil if none are flagged.
+func (c *CountryNode) Risk() *CountryRisk {
+	profiles := c.Profiles()
+
+	for i := len(profiles) - 1; i >= 0; i-- {
+		listed := profiles[i].Goods()
+		if len(listed) == 0 {
+			continue
+		}
+
+		r := &CountryRisk{
+			Country:      c,
+			ProfileYear:  profiles[i].ProfileYear,
+			SectorCounts: make(map[string]int),
+		}
+
+		for _, cg := range listed {
+			if !cg.Flagged() {
+				continue
+			}
+
+			gr := GoodRisk{
+				GoodID:           cg.GoodID,
+				ChildLabor:       cg.ChildLabor.True(),
+				ForcedLabor:      cg.ForcedLabor.True(),
+				ForcedChildLabor: cg.ForcedChildLabor.True(),
+			}
+			if g := cg.Good(); g != nil {
+				gr.Good = g.Name
+				gr.SectorID = g.SectorID
+				if s := g.Sector(); s != nil {
+					gr.Sector = s.Name
+				}
+			}
+
+			r.Goods = append(r.Goods, gr)
+			r.SectorCounts[gr.Sector]++
+		}
+
+		if len(r.Goods) == 0 {
+			return nil
+		}
+
+		sort.SliceStable(r.Goods, func(i, j int) bool {
+			return r.Goods[i].Good < r.Goods[j].Good
+		})
+
+		return r
+	}
+
+	return nil
+}
+
+// RiskReport returns the flagged goods of every country with any, ranked
+// by number of flagged goods, most first. Countries with equal numbers are
+// ordered by name.
+func (idx *Index) RiskReport() []*CountryRisk {
+	var report []*CountryRisk
+	for _, c := range idx.countryList {
+		if r := c.Risk(); r != nil {
+			report = append(report, r)
+		}
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		if len(report[i].Goods) != len(report[j].Goods) {
+			return len(report[i].Goods) > len(report[j].Goods)
+		}
+
+		return report[i].Country.Name < report[j].Country.Name
+	})
+
+	for i, r := range report {
+		r.Rank = i + 1
+		if i > 0 && len(r.Goods) == len(report[i-1].Goods) {
+			r.Rank = report[i-1].Rank
+		}
+	}
+
+	return report
+}
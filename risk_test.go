@@ -0,0 +1,89 @@
+package laborstats
+
+import "testing"
+
+func newRiskIndex() *Index {
+	yes, no := Bool{State: BoolTrue}, Bool{State: BoolFalse}
+
+	return NewIndex(&Dataset{
+		Sectors: []Sector{
+			{ID: 1, Name: "Agriculture"},
+			{ID: 2, Name: "Manufacturing"},
+		},
+		Goods: []Good{
+			{ID: 1, Name: "Cocoa", SectorID: 1},
+			{ID: 2, Name: "Coffee", SectorID: 1},
+			{ID: 3, Name: "Bricks", SectorID: 2},
+			{ID: 4, Name: "Garments", SectorID: 2},
+		},
+		Countries: []Country{
+			{ID: 1, Name: "Ghana", ISO2: "GH", ISO3: "GHA"},
+			{ID: 2, Name: "India", ISO2: "IN", ISO3: "IND"},
+			{ID: 3, Name: "Brazil", ISO2: "BR", ISO3: "BRA"},
+			{ID: 4, Name: "Chile", ISO2: "CL", ISO3: "CHL"},
+		},
+		CountryProfiles: []CountryProfile{
+			{ID: 10, CountryID: 1, ProfileYear: 2013},
+			{ID: 11, CountryID: 1, ProfileYear: 2014},
+			{ID: 12, CountryID: 1, ProfileYear: 2015},
+			{ID: 20, CountryID: 2, ProfileYear: 2014},
+			{ID: 30, CountryID: 3, ProfileYear: 2014},
+			{ID: 40, CountryID: 4, ProfileYear: 2014},
+		},
+		CountryGoods: []CountryGood{
+			{CountryProfileID: 10, GoodID: 3, ChildLabor: yes},
+			{CountryProfileID: 11, GoodID: 1, ChildLabor: yes, ForcedChildLabor: yes},
+			{CountryProfileID: 11, GoodID: 2, ChildLabor: no, ForcedLabor: no},
+			{CountryProfileID: 20, GoodID: 3, ChildLabor: yes, ForcedLabor: yes},
+			{CountryProfileID: 20, GoodID: 4, ChildLabor: yes},
+			{CountryProfileID: 20, GoodID: 2, ForcedLabor: yes},
+			{CountryProfileID: 30, GoodID: 2, ChildLabor: yes},
+			{CountryProfileID: 40, GoodID: 1, ChildLabor: no},
+		},
+	})
+}
+
+func TestCountryRisk(t *testing.T) {
+	idx := newRiskIndex()
+
+	r := idx.Country(1).Risk()
+	if r == nil {
+		t.Fatal("No risk reported for Ghana.")
+	}
+
+	if r.ProfileYear != 2014 {
+		t.Error("Invalid ProfileYear: ", r.ProfileYear)
+	}
+
+	expected := GoodRisk{GoodID: 1, Good: "Cocoa", SectorID: 1, Sector: "Agriculture", ChildLabor: true, ForcedChildLabor: true}
+	if len(r.Goods) != 1 || r.Goods[0] != expected {
+		t.Error("Invalid Goods: ", r.Goods)
+	}
+
+	if idx.Country(4).Risk() != nil {
+		t.Error("Risk reported for a country without flagged goods.")
+	}
+}
+
+func TestRiskReport(t *testing.T) {
+	report := newRiskIndex().RiskReport()
+	if len(report) != 3 {
+		t.Fatal("Invalid report length: ", len(report))
+	}
+
+	india := report[0]
+	if india.Country.Name != "India" || india.Rank != 1 || len(india.Goods) != 3 {
+		t.Error("Invalid first entry: ", india)
+	}
+	if india.Goods[0].Good != "Bricks" || india.Goods[2].Good != "Garments" {
+		t.Error("Goods not ordered by name: ", india.Goods)
+	}
+	if india.SectorCounts["Manufacturing"] != 2 || india.SectorCounts["Agriculture"] != 1 {
+		t.Error("Invalid SectorCounts: ", india.SectorCounts)
+	}
+
+	if report[1].Country.Name != "Brazil" || report[1].Rank != 2 ||
+		report[2].Country.Name != "Ghana" || report[2].Rank != 2 {
+		t.Error("Invalid tied ranking: ", report[1], report[2])
+	}
+}
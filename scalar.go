@@ -0,0 +1,33 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var errNotScalar = errors.New("not a JSON string, number or null")
+
+// decodeScalar returns the text of a JSON string or number. The API is not
+// consistent about which of the two it uses for a field, so values such as
+// ages and years are decoded from either. isNull is true if b is null.
+func decodeScalar(b []byte) (raw string, isNull bool, err error) {
+	b = bytes.TrimSpace(b)
+
+	switch {
+	case bytes.Equal(b, []byte("null")):
+		return "", true, nil
+	case len(b) > 0 && b[0] == '"':
+		if err := json.Unmarshal(b, &raw); err != nil {
+			return "", false, err
+		}
+		return raw, false, nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return "", false, errNotScalar
+	}
+
+	return n.String(), false, nil
+}
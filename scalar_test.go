@@ -0,0 +1,32 @@
+package laborstats
+
+import "testing"
+
+func TestDecodeScalar(t *testing.T) {
+	cases := []struct {
+		in     string
+		raw    string
+		isNull bool
+		isErr  bool
+	}{
+		{`"15 years"`, "15 years", false, false},
+		{` 15 `, "15", false, false},
+		{`2.5`, "2.5", false, false},
+		{`null`, "", true, false},
+		{`""`, "", false, false},
+		{`true`, "", false, true},
+		{`{"a": 1}`, "", false, true},
+	}
+
+	for _, c := range cases {
+		raw, isNull, err := decodeScalar([]byte(c.in))
+		if (err != nil) != c.isErr {
+			t.Error("Invalid error for ", c.in, ": ", err)
+			continue
+		}
+
+		if raw != c.raw || isNull != c.isNull {
+			t.Error("Invalid scalar for ", c.in, ": ", raw, isNull)
+		}
+	}
+}
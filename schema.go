@@ -0,0 +1,85 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaError is returned in Strict mode when the keys of a response do not
+// match the fields of the endpoint's result type. It matches
+// ErrSchemaMismatch.
+type SchemaError struct {
+	// Endpoint is the request path of the endpoint queried.
+	Endpoint string
+	// Unknown lists the keys returned by the API which no field decodes.
+	Unknown []string
+	// Missing lists the fields absent from at least one returned record.
+	Missing []string
+}
+
+func (e *SchemaError) Error() string {
+	var details []string
+	if len(e.Unknown) > 0 {
+		details = append(details, fmt.Sprintf("unknown keys: %s", strings.Join(e.Unknown, ", ")))
+	}
+	if len(e.Missing) > 0 {
+		details = append(details, fmt.Sprintf("missing keys: %s", strings.Join(e.Missing, ", ")))
+	}
+
+	return fmt.Sprintf("%s %s (endpoint: %s)", ErrSchemaMismatch, strings.Join(details, "; "), e.Endpoint)
+}
+
+// Is reports whether the error matches target.
+func (e *SchemaError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+// checkSchema compares the keys of each record in a raw API response with
+// fields, returning a *SchemaError if any record has unknown or missing keys.
+func checkSchema(path string, fields []string, b []byte) error {
+	var records []map[string]json.RawMessage
+
+	err := json.Unmarshal(b, &records)
+	if err != nil {
+		return err
+	}
+
+	unknown := make(map[string]bool)
+	missing := make(map[string]bool)
+
+	for _, record := range records {
+		for key := range record {
+			if !containsString(fields, key) {
+				unknown[key] = true
+			}
+		}
+
+		for _, field := range fields {
+			if _, ok := record[field]; !ok {
+				missing[field] = true
+			}
+		}
+	}
+
+	if len(unknown) == 0 && len(missing) == 0 {
+		return nil
+	}
+
+	return &SchemaError{
+		Endpoint: path,
+		Unknown:  sortedKeys(unknown),
+		Missing:  sortedKeys(missing),
+	}
+}
+
+func sortedKeys(set map[string]bool) []string {
+	var keys []string
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
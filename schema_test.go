@@ -0,0 +1,207 @@
+package laborstats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Matches a well formed struct tag, as checked by go vet.
+var structTagPattern = regexp.MustCompile(`^[A-Za-z_]+:"[^"]*"( [A-Za-z_]+:"[^"]*")*$`)
+
+type modelSchema struct {
+	path     string
+	model    reflect.Type
+	fields   []string
+	dataFile string
+	// roundTrip decodes a response and encodes the results again.
+	roundTrip func([]byte) ([]byte, error)
+}
+
+func schemaOf[T any](e Endpoint[T], dataFile string) modelSchema {
+	var model T
+
+	return modelSchema{
+		path:     e.Path,
+		model:    reflect.TypeOf(model),
+		fields:   e.fields(),
+		dataFile: dataFile,
+		roundTrip: func(b []byte) ([]byte, error) {
+			results, err := e.decode(b)
+			if err != nil {
+				return nil, err
+			}
+
+			return json.Marshal(results)
+		},
+	}
+}
+
+var modelSchemas = []modelSchema{
+	schemaOf(AdvancementLevelEndpoint, "./testdata/advancement_level.json"),
+	schemaOf(CountryEndpoint, "./testdata/country.json"),
+	schemaOf(CountryDataEndpoint, "./testdata/country_data.json"),
+	schemaOf(CountryGoodsEndpoint, "./testdata/country_goods.json"),
+	schemaOf(CountryProfileEndpoint, "./testdata/country_profile.json"),
+	schemaOf(CountryStatsEndpoint, "./testdata/country_stats.json"),
+	schemaOf(GoodEndpoint, "./testdata/good.json"),
+	schemaOf(RegionEndpoint, "./testdata/region.json"),
+	schemaOf(SectorEndpoint, "./testdata/sector.json"),
+	schemaOf(SuggestedActionAreaEndpoint, "./testdata/suggested_action_area.json"),
+	schemaOf(SuggestedActionEndpoint, "./testdata/suggested_actions.json"),
+}
+
+func TestModelTags(t *testing.T) {
+	for _, s := range modelSchemas {
+		names := make(map[string]bool)
+
+		for i := 0; i < s.model.NumField(); i++ {
+			f := s.model.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			if !structTagPattern.MatchString(string(f.Tag)) {
+				t.Errorf("%s.%s: malformed struct tag: %s", s.model.Name(), f.Name, f.Tag)
+				continue
+			}
+
+			tag, ok := f.Tag.Lookup("json")
+			if !ok {
+				t.Errorf("%s.%s: missing json tag", s.model.Name(), f.Name)
+				continue
+			}
+
+			name, opts := splitTag(tag)
+			if name == "" {
+				t.Errorf("%s.%s: empty json name", s.model.Name(), f.Name)
+			}
+			if names[name] {
+				t.Errorf("%s.%s: duplicate json name %q", s.model.Name(), f.Name, name)
+			}
+			names[name] = true
+
+			for _, opt := range opts {
+				if opt != "omitempty" && opt != "string" {
+					t.Errorf("%s.%s: unknown json option %q", s.model.Name(), f.Name, opt)
+				}
+			}
+		}
+	}
+}
+
+func splitTag(tag string) (string, []string) {
+	parts := strings.Split(tag, ",")
+
+	return parts[0], parts[1:]
+}
+
+func TestModelsMatchTestdata(t *testing.T) {
+	for _, s := range modelSchemas {
+		dataMock, err := getDataMock(s.dataFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = checkSchema(s.path, s.fields, dataMock)
+
+		var schemaErr *SchemaError
+		switch {
+		case s.path == countryDataURI:
+			// The second record omits its hazardous work status.
+			if !errors.As(err, &schemaErr) ||
+				len(schemaErr.Unknown) != 0 ||
+				!reflect.DeepEqual(schemaErr.Missing, []string{"minimum_age_for_hazardous_work_established"}) {
+				t.Error("Invalid schema error: ", err)
+			}
+		case err != nil:
+			t.Errorf("%s: %s", s.path, err)
+		}
+	}
+}
+
+func TestQueryStrict(t *testing.T) {
+	a, stop := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": 1, "name": "Mining", "sector_code": "MIN"}, {"name": "Agriculture"}]`))
+	}))
+	defer stop()
+
+	result, err := a.QuerySectorContext(context.Background())
+	if err != nil || len(result) != 2 {
+		t.Error("Invalid lenient result: ", result, err)
+	}
+
+	a.Strict = true
+
+	result, err = a.QuerySectorContext(context.Background())
+	if result != nil || !errors.Is(err, ErrSchemaMismatch) {
+		t.Fatal("Expected ErrSchemaMismatch, got: ", err)
+	}
+
+	var schemaErr *SchemaError
+	if !errors.As(err, &schemaErr) {
+		t.Fatal("Expected *SchemaError, got: ", err)
+	}
+
+	if schemaErr.Endpoint != sectorURI ||
+		!reflect.DeepEqual(schemaErr.Unknown, []string{"sector_code"}) ||
+		!reflect.DeepEqual(schemaErr.Missing, []string{"id"}) {
+		t.Error("Invalid schema error: ", schemaErr)
+	}
+}
+
+// roundTripResponses are responses using the API's less common encodings:
+// absent keys, numbers where strings are usual, null, and "Yes".
+var roundTripResponses = map[string][]string{
+	countryDataURI: {
+		`[{"country_profile_id":1}]`,
+		`[{"country_profile_id":1,"c_138_ratified":true,"c_182_ratified":null,"palermo_ratified":1,` +
+			`"minimum_age_for_work":15,"minimum_age_for_hazardous_work_established":"No",` +
+			`"minimum_age_for_hazardous_work":"18","minimum_age_for_compulsory_edu":null}]`,
+	},
+	countryGoodsURI: {
+		`[{"country_profile_id":1,"good_id":2,"child_Labor":null,"forced_labor":"Yes","forced_child_labor":true}]`,
+	},
+	countryStatsURI: {
+		`[{"country_profile_id":1,"cws_age_range":14,"cws_total_percentage_of_workin":"N/A",` +
+			`"cws_total_working_population":"1,234","esas_percentage":0,"upcr_year":2012,"upcr_rate":null}]`,
+	},
+	suggestedActionURI: {
+		`[{"id":1,"country_profile_id":2,"area_id":3,"name":"Enforce laws"}]`,
+		`[{"id":1,"country_profile_id":2,"area_id":3,"year":2014}]`,
+	},
+}
+
+func TestModelsRoundTrip(t *testing.T) {
+	for _, s := range modelSchemas {
+		dataMock, err := getDataMock(s.dataFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, response := range append([]string{string(dataMock)}, roundTripResponses[s.path]...) {
+			encoded, err := s.roundTrip([]byte(response))
+			if err != nil {
+				t.Errorf("%s: %s", s.path, err)
+				continue
+			}
+
+			var expected, actual interface{}
+			if err := json.Unmarshal([]byte(response), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(encoded, &actual); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("%s: round trip changed response: %s", s.path, encoded)
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package laborstats
+
+// ScreenItem is a good sourced from a country, such as a line of a bill of
+// materials.
+type ScreenItem struct {
+	// Good is the name or numeric ID of the good. Names are matched as by
+	// Index.GoodByName.
+	Good string
+	// Country is the ISO 3166-1 alpha-2 or alpha-3 code of the sourcing
+	// country. Names and IDs are accepted as by Index.LookupCountry.
+	Country string
+}
+
+// ScreenResult is the outcome of screening a ScreenItem.
+type ScreenResult struct {
+	Item ScreenItem
+	// Good is the matched good, or nil if none matched.
+	Good *GoodNode
+	// Country is the matched country, or nil if none matched.
+	Country *CountryNode
+	// Listed reports whether the good is listed for the country as produced
+	// with child labor, forced labor or forced child labor.
+	Listed           bool
+	ChildLabor       bool
+	ForcedLabor      bool
+	ForcedChildLabor bool
+	// ProfileYear is the year of the most recent profile of the country
+	// listing the good, or 0 if no profile lists it.
+	ProfileYear int
+}
+
+// Matched reports whether both the good and the country were found.
+func (r ScreenResult) Matched() bool {
+	return r.Good != nil && r.Country != nil
+}
+
+// Screen checks each item against the goods listed on its country's
+// profiles, using the most recent profile listing the good. Results are
+// returned in the order of items; items whose good or country cannot be
+// matched are returned unlisted, and can be found with Matched.
+func (idx *Index) Screen(items []ScreenItem) []ScreenResult {
+	results := make([]ScreenResult, len(items))
+
+	for i, item := range items {
+		r := ScreenResult{
+			Item:    item,
+			Good:    idx.LookupGood(item.Good),
+			Country: idx.LookupCountry(item.Country),
+		}
+
+		if r.Matched() {
+			if cg := r.Country.listing(r.Good.ID); cg != nil {
+				r.Listed = cg.Flagged()
+				r.ChildLabor = cg.ChildLabor.True()
+				r.ForcedLabor = cg.ForcedLabor.True()
+				r.ForcedChildLabor = cg.ForcedChildLabor.True()
+				r.ProfileYear = cg.Profile().ProfileYear
+			}
+		}
+
+		results[i] = r
+	}
+
+	return results
+}
+
+// listing returns the good's listing on the country's most recent profile
+// which lists it, or nil.
+func (c *CountryNode) listing(goodID int) *CountryGoodNode {
+	profiles := c.Profiles()
+
+	for i := len(profiles) - 1; i >= 0; i-- {
+		for _, cg := range profiles[i].Goods() {
+			if cg.GoodID == goodID {
+				return cg
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package laborstats
+
+import "testing"
+
+func TestScreen(t *testing.T) {
+	idx := newRiskIndex()
+
+	results := idx.Screen([]ScreenItem{
+		{Good: "cocoa", Country: "GH"},
+		{Good: "Brick", Country: "IND"},
+		{Good: "2", Country: "gh"},
+		{Good: "Cofee", Country: "BR"},
+		{Good: "Cocoa", Country: "CL"},
+		{Good: "Garments", Country: "BR"},
+		{Good: "Tungsten", Country: "GH"},
+		{Good: "Cocoa", Country: "XX"},
+	})
+
+	if len(results) != 8 {
+		t.Fatal("Invalid result length: ", len(results))
+	}
+
+	r := results[0]
+	if !r.Listed || !r.ChildLabor || r.ForcedLabor || !r.ForcedChildLabor || r.ProfileYear != 2014 {
+		t.Error("Invalid cocoa result: ", r)
+	}
+
+	r = results[1]
+	if r.Good == nil || r.Good.Name != "Bricks" || !r.Listed || !r.ForcedLabor || r.ProfileYear != 2014 {
+		t.Error("Invalid bricks result: ", r)
+	}
+
+	r = results[2]
+	if r.Good == nil || r.Good.Name != "Coffee" || r.Listed || r.ProfileYear != 2014 {
+		t.Error("Unflagged listing reported as listed: ", r)
+	}
+
+	r = results[3]
+	if r.Good == nil || r.Good.Name != "Coffee" || !r.Listed {
+		t.Error("Misspelled good not matched: ", r)
+	}
+
+	if results[4].Listed || results[4].ProfileYear != 2014 {
+		t.Error("Invalid unflagged result: ", results[4])
+	}
+
+	if results[5].Listed || results[5].ProfileYear != 0 || !results[5].Matched() {
+		t.Error("Unlisted good reported as listed: ", results[5])
+	}
+
+	if results[6].Matched() || results[6].Good != nil {
+		t.Error("Unknown good matched: ", results[6])
+	}
+
+	if results[7].Matched() || results[7].Country != nil {
+		t.Error("Unknown country matched: ", results[7])
+	}
+}
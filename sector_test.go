@@ -8,10 +8,7 @@ func TestSectorUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := SectorAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := SectorEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -8,10 +8,7 @@ func TestSuggestedActionAreaUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := SuggestedActionAreaAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := SuggestedActionAreaEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -8,10 +8,7 @@ func TestSuggestedActionsUnmarshalData(t *testing.T) {
 		t.Error(err)
 	}
 
-	api := SuggestedActionAPI{}
-	api.RawResponse = dataMock
-
-	result, err := api.unmarshalData()
+	result, err := SuggestedActionEndpoint.decode(dataMock)
 	if err != nil {
 		t.Error(err)
 	}
@@ -33,7 +30,7 @@ func TestSuggestedActionsUnmarshalData(t *testing.T) {
 	if fRes.Name != "Create better laws" {
 		t.Error("Invalid Name value: ", fRes.Name)
 	}
-	if fRes.Year != "2013 - 2014" {
+	if fRes.Year != (YearRange{2013, 2014, `"2013 - 2014"`}) {
 		t.Error("Invalid Year value: ", fRes.Year)
 	}
 }
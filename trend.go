@@ -0,0 +1,145 @@
+package laborstats
+
+import (
+	"sort"
+	"strings"
+)
+
+// Movement classifies the change in a country's advancement level between
+// two profiles.
+type Movement int
+
+const (
+	// MovementUnknown is used when either level is missing or unranked.
+	MovementUnknown Movement = iota
+	Improved
+	Declined
+	Unchanged
+)
+
+func (m Movement) String() string {
+	switch m {
+	case Improved:
+		return "Improved"
+	case Declined:
+		return "Declined"
+	case Unchanged:
+		return "Unchanged"
+	}
+
+	return "Unknown"
+}
+
+// Rank returns the ordinal rank of the level, from 1 for No Advancement to
+// 4 for Significant Advancement, or 0 for levels such as No Assessment which
+// cannot be ranked. Variants of a level, such as Minimal Advancement with
+// efforts made but continued practices, share its rank.
+func (l AdvancementLevel) Rank() int {
+	name := strings.ToLower(l.Name)
+
+	switch {
+	case strings.Contains(name, "significant"):
+		return 4
+	case strings.Contains(name, "moderate"):
+		return 3
+	case strings.Contains(name, "minimal"):
+		return 2
+	case strings.Contains(name, "no advancement"):
+		return 1
+	}
+
+	return 0
+}
+
+// TrendPoint is a country's advancement level in one profile year.
+type TrendPoint struct {
+	Year int
+	// Level is the profile's advancement level, or nil if it is not in the
+	// dataset.
+	Level *AdvancementLevel
+	// Rank is the level's ordinal rank, or 0 if it cannot be ranked.
+	Rank int
+	// Movement is the change from the previous point. It is MovementUnknown
+	// for the first point.
+	Movement Movement
+}
+
+// LevelChange is a change in a country's advancement level between two
+// profile years.
+type LevelChange struct {
+	Country  *CountryNode
+	From     *AdvancementLevel
+	To       *AdvancementLevel
+	Movement Movement
+}
+
+// AdvancementTrend returns the country's advancement level for each profile
+// year, oldest first.
+func (c *CountryNode) AdvancementTrend() []TrendPoint {
+	var trend []TrendPoint
+
+	for _, p := range c.Profiles() {
+		point := TrendPoint{Year: p.ProfileYear, Level: p.AdvancementLevel()}
+		if point.Level != nil {
+			point.Rank = point.Level.Rank()
+		}
+
+		if len(trend) > 0 {
+			point.Movement = compareLevels(trend[len(trend)-1].Level, point.Level)
+		}
+
+		trend = append(trend, point)
+	}
+
+	return trend
+}
+
+// LevelChanges returns the countries profiled in both fromYear and toYear
+// whose advancement level differs between them, ordered by country name.
+func (idx *Index) LevelChanges(fromYear, toYear int) []LevelChange {
+	var changes []LevelChange
+
+	for _, c := range idx.countryList {
+		from, to := c.Profile(fromYear), c.Profile(toYear)
+		if from == nil || to == nil || from.AdLevelID == to.AdLevelID {
+			continue
+		}
+
+		changes = append(changes, LevelChange{
+			Country:  c,
+			From:     from.AdvancementLevel(),
+			To:       to.AdvancementLevel(),
+			Movement: compareLevels(from.AdvancementLevel(), to.AdvancementLevel()),
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Country.Name < changes[j].Country.Name
+	})
+
+	return changes
+}
+
+// compareLevels classifies the movement from one level to another.
+func compareLevels(from, to *AdvancementLevel) Movement {
+	if from == nil || to == nil {
+		return MovementUnknown
+	}
+
+	if from.ID == to.ID {
+		return Unchanged
+	}
+
+	fromRank, toRank := from.Rank(), to.Rank()
+
+	switch {
+	case fromRank == 0 || toRank == 0:
+		return MovementUnknown
+	case toRank > fromRank:
+		return Improved
+	case toRank < fromRank:
+		return Declined
+	}
+
+	return Unchanged
+}
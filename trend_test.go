@@ -0,0 +1,104 @@
+package laborstats
+
+import "testing"
+
+func newTrendIndex() *Index {
+	return NewIndex(&Dataset{
+		AdvancementLevels: []AdvancementLevel{
+			{ID: 1, Name: "Moderate Advancement"},
+			{ID: 2, Name: "Minimal Advancement"},
+			{ID: 3, Name: "Significant Advancement"},
+			{ID: 4, Name: "No Advancement"},
+			{ID: 5, Name: "No Assessment"},
+		},
+		Countries: []Country{
+			{ID: 1, Name: "Peru"},
+			{ID: 2, Name: "Nepal"},
+			{ID: 3, Name: "Kenya"},
+			{ID: 4, Name: "Fiji"},
+		},
+		CountryProfiles: []CountryProfile{
+			{ID: 10, CountryID: 1, ProfileYear: 2015, AdLevelID: 3},
+			{ID: 11, CountryID: 1, ProfileYear: 2013, AdLevelID: 2},
+			{ID: 12, CountryID: 1, ProfileYear: 2014, AdLevelID: 2},
+			{ID: 20, CountryID: 2, ProfileYear: 2013, AdLevelID: 1},
+			{ID: 21, CountryID: 2, ProfileYear: 2015, AdLevelID: 4},
+			{ID: 30, CountryID: 3, ProfileYear: 2013, AdLevelID: 1},
+			{ID: 31, CountryID: 3, ProfileYear: 2015, AdLevelID: 1},
+			{ID: 40, CountryID: 4, ProfileYear: 2013, AdLevelID: 5},
+			{ID: 41, CountryID: 4, ProfileYear: 2015, AdLevelID: 2},
+		},
+	})
+}
+
+func TestAdvancementLevelRank(t *testing.T) {
+	cases := map[string]int{
+		"No Advancement":      1,
+		"Minimal Advancement": 2,
+		"Minimal Advancement – Efforts Made but Continued Practice that Delayed Advancement": 2,
+		"Moderate Advancement":    3,
+		"Significant Advancement": 4,
+		"No Assessment":           0,
+	}
+
+	for name, expected := range cases {
+		if r := (AdvancementLevel{Name: name}).Rank(); r != expected {
+			t.Errorf("Rank(%q) = %d, expected %d", name, r, expected)
+		}
+	}
+}
+
+func TestAdvancementTrend(t *testing.T) {
+	trend := newTrendIndex().Country(1).AdvancementTrend()
+	if len(trend) != 3 {
+		t.Fatal("Invalid trend length: ", len(trend))
+	}
+
+	expected := []struct {
+		year     int
+		level    string
+		rank     int
+		movement Movement
+	}{
+		{2013, "Minimal Advancement", 2, MovementUnknown},
+		{2014, "Minimal Advancement", 2, Unchanged},
+		{2015, "Significant Advancement", 4, Improved},
+	}
+
+	for i, e := range expected {
+		p := trend[i]
+		if p.Year != e.year || p.Level == nil || p.Level.Name != e.level || p.Rank != e.rank || p.Movement != e.movement {
+			t.Errorf("Invalid trend point %d: %+v", i, p)
+		}
+	}
+}
+
+func TestLevelChanges(t *testing.T) {
+	changes := newTrendIndex().LevelChanges(2013, 2015)
+	if len(changes) != 3 {
+		t.Fatal("Invalid changes: ", changes)
+	}
+
+	expected := []struct {
+		country  string
+		movement Movement
+	}{
+		{"Fiji", MovementUnknown},
+		{"Nepal", Declined},
+		{"Peru", Improved},
+	}
+
+	for i, e := range expected {
+		if changes[i].Country.Name != e.country || changes[i].Movement != e.movement {
+			t.Errorf("Invalid change %d: %s %s", i, changes[i].Country.Name, changes[i].Movement)
+		}
+	}
+
+	if changes[2].From.Name != "Minimal Advancement" || changes[2].To.Name != "Significant Advancement" {
+		t.Error("Invalid levels: ", changes[2].From, changes[2].To)
+	}
+
+	if len(newTrendIndex().LevelChanges(2014, 2015)) != 1 {
+		t.Error("Countries without a profile in both years reported.")
+	}
+}
@@ -0,0 +1,144 @@
+package laborstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// YearRange is a year or span of years decoded from the API, which reports
+// them as strings such as "2010", "2010-11" or "2013 - 2014". The API uses
+// "0000" for a missing year, which decodes as the zero YearRange.
+type YearRange struct {
+	// Start is the first year of the range.
+	Start int
+	// End is the last year of the range. It equals Start for a single year.
+	End int
+	// Raw is the JSON the API sent, such as `"2010-11"` or `2012`.
+	Raw string
+}
+
+// Missing reports whether the range holds no year.
+func (r YearRange) Missing() bool {
+	return r.Start == 0
+}
+
+// Contains reports whether year falls within the range.
+func (r YearRange) Contains(year int) bool {
+	return !r.Missing() && year >= r.Start && year <= r.End
+}
+
+// Overlaps reports whether the ranges have at least one year in common.
+func (r YearRange) Overlaps(o YearRange) bool {
+	return !r.Missing() && !o.Missing() && r.Start <= o.End && o.Start <= r.End
+}
+
+// Compare orders ranges by start year, then by end year, returning -1, 0 or
+// +1. Missing ranges sort before all others.
+func (r YearRange) Compare(o YearRange) int {
+	switch {
+	case r.Start < o.Start:
+		return -1
+	case r.Start > o.Start:
+		return 1
+	case r.End < o.End:
+		return -1
+	case r.End > o.End:
+		return 1
+	}
+
+	return 0
+}
+
+// Before reports whether the range ends before o starts.
+func (r YearRange) Before(o YearRange) bool {
+	return !r.Missing() && !o.Missing() && r.End < o.Start
+}
+
+// String returns the range as "2010" or "2010-2011", or "" if it is missing.
+func (r YearRange) String() string {
+	switch {
+	case r.Missing():
+		return ""
+	case r.Start == r.End:
+		return strconv.Itoa(r.Start)
+	}
+
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// UnmarshalJSON decodes a range from a JSON string, number or null. Values
+// which cannot be parsed decode as a missing range, keeping Raw.
+func (r *YearRange) UnmarshalJSON(b []byte) error {
+	raw, isNull, err := decodeScalar(b)
+	if err != nil {
+		return fmt.Errorf("Invalid year: %s", b)
+	}
+
+	*r = YearRange{}
+	if !isNull {
+		*r = parseYearRange(raw)
+	}
+	r.Raw = string(bytes.TrimSpace(b))
+
+	return nil
+}
+
+// MarshalJSON writes Raw unless Start or End have changed since decoding,
+// and otherwise the range's String form, or null if it is missing.
+func (r YearRange) MarshalJSON() ([]byte, error) {
+	if rawMatches(r, r.Raw) {
+		return []byte(r.Raw), nil
+	}
+
+	if r.Missing() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(r.String())
+}
+
+func parseYearRange(raw string) YearRange {
+	var r YearRange
+
+	parts := strings.SplitN(raw, "-", 2)
+
+	start, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil || start <= 0 {
+		return r
+	}
+
+	end := start
+	if len(parts) == 2 {
+		s := strings.TrimSpace(parts[1])
+
+		end, err = strconv.Atoi(s)
+		if err != nil {
+			return r
+		}
+
+		// Abbreviated ends such as "2010-11" or "2018-9" take the leading
+		// digits of the start, rolling over where needed as in "1999-00".
+		if len(s) <= 2 {
+			span := 10
+			if len(s) == 2 {
+				span = 100
+			}
+
+			end += start - start%span
+			if end < start {
+				end += span
+			}
+		}
+
+		if end < start {
+			return r
+		}
+	}
+
+	r.Start, r.End = start, end
+
+	return r
+}
@@ -0,0 +1,86 @@
+package laborstats
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestYearRangeUnmarshalJSON(t *testing.T) {
+	cases := map[string]YearRange{
+		`"2010"`:        {2010, 2010, `"2010"`},
+		`"2010-11"`:     {2010, 2011, `"2010-11"`},
+		`"1999-00"`:     {1999, 2000, `"1999-00"`},
+		`"2010-2"`:      {2010, 2012, `"2010-2"`},
+		`"2019-0"`:      {2019, 2020, `"2019-0"`},
+		`"2013 - 2014"`: {2013, 2014, `"2013 - 2014"`},
+		`2012`:          {2012, 2012, `2012`},
+		`"0000"`:        {0, 0, `"0000"`},
+		`"2014-2012"`:   {0, 0, `"2014-2012"`},
+		`"N/A"`:         {0, 0, `"N/A"`},
+		`null`:          {0, 0, `null`},
+	}
+
+	for in, expected := range cases {
+		var r YearRange
+		if err := json.Unmarshal([]byte(in), &r); err != nil {
+			t.Errorf("Unmarshal(%s): %s", in, err)
+			continue
+		}
+
+		if r != expected {
+			t.Errorf("Unmarshal(%s) = %+v, expected %+v", in, r, expected)
+		}
+	}
+}
+
+func TestYearRangeMarshalJSON(t *testing.T) {
+	cases := map[string]YearRange{
+		`"2010-11"`:   {2010, 2011, `"2010-11"`},
+		`"2010-2011"`: {Start: 2010, End: 2011},
+		`"2014"`:      {Start: 2014, End: 2014},
+		`"2012"`:      {2012, 2012, `"2010-11"`},
+		`null`:        {},
+	}
+
+	for expected, r := range cases {
+		b, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != expected {
+			t.Errorf("Marshal(%+v) = %s, expected %s", r, b, expected)
+		}
+	}
+}
+
+func TestYearRangeComparison(t *testing.T) {
+	r2010 := parseYearRange("2010-11")
+	r2011 := parseYearRange("2011")
+	r2013 := parseYearRange("2013 - 2014")
+	missing := parseYearRange("0000")
+
+	if !r2010.Contains(2011) || r2010.Contains(2012) || missing.Contains(0) {
+		t.Error("Invalid Contains result.")
+	}
+
+	if !r2010.Overlaps(r2011) || r2010.Overlaps(r2013) || missing.Overlaps(missing) {
+		t.Error("Invalid Overlaps result.")
+	}
+
+	if !r2010.Before(r2013) || r2010.Before(r2011) || missing.Before(r2010) {
+		t.Error("Invalid Before result.")
+	}
+
+	if r2010.Compare(r2011) != -1 || r2013.Compare(r2011) != 1 || r2011.Compare(parseYearRange("2011")) != 0 {
+		t.Error("Invalid Compare result.")
+	}
+
+	if missing.Compare(r2010) != -1 {
+		t.Error("Missing range not ordered first.")
+	}
+
+	if r2010.String() != "2010-2011" || r2011.String() != "2011" || missing.String() != "" {
+		t.Error("Invalid String result.")
+	}
+}